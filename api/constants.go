@@ -17,6 +17,12 @@ const (
 	RegionTurkey                   = "tr1"
 	RegionRussia                   = "ru"
 	RegionPBE                      = "pbe1"
+	RegionPhilippines              = "ph2"
+	RegionSingapore                = "sg2"
+	RegionThailand                 = "th2"
+	RegionTaiwan                   = "tw2"
+	RegionVietnam                  = "vn2"
+	RegionMiddleEast               = "me1"
 )
 
 var (
@@ -34,5 +40,11 @@ var (
 		RegionTurkey,
 		RegionRussia,
 		RegionPBE,
+		RegionPhilippines,
+		RegionSingapore,
+		RegionThailand,
+		RegionTaiwan,
+		RegionVietnam,
+		RegionMiddleEast,
 	}
 )
@@ -0,0 +1,343 @@
+package datadragon
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	cacheFileMeta           = "meta.json"
+	cacheFileChampions      = "champions.json"
+	cacheFileItems          = "items.json"
+	cacheFileMasteries      = "masteries.json"
+	cacheFileRunes          = "runes.json"
+	cacheFileReforgedRunes  = "reforged_runes.json"
+	cacheFileSummonerSpells = "summoner_spells.json"
+	cacheFileProfileIcons   = "profile_icons.json"
+)
+
+type cacheMeta struct {
+	Version  string       `json:"version"`
+	Language languageCode `json:"language"`
+}
+
+type cachedChampions struct {
+	// Complete is true if the list of all champions was retrieved
+	Complete  bool                            `json:"complete"`
+	Champions map[string]ChampionDataExtended `json:"champions"`
+}
+
+// CacheStats contains the number of cached entries of each type and the version and language of the cached data
+type CacheStats struct {
+	Version        string
+	Language       languageCode
+	Champions      int
+	Items          int
+	Masteries      int
+	Runes          int
+	ReforgedRunes  int
+	SummonerSpells int
+	ProfileIcons   int
+}
+
+// CacheStats returns the number of currently cached entries of each type
+func (c *Client) CacheStats() CacheStats {
+	var stats CacheStats
+	c.versionMu.RLock()
+	stats.Version, stats.Language = c.version, c.language
+	c.versionMu.RUnlock()
+	c.championsMu.RLock()
+	stats.Champions = len(c.championsByID)
+	c.championsMu.RUnlock()
+	c.itemsMu.RLock()
+	stats.Items = len(c.items)
+	c.itemsMu.RUnlock()
+	c.masteriesMu.RLock()
+	stats.Masteries = len(c.masteries)
+	c.masteriesMu.RUnlock()
+	c.runesMu.RLock()
+	stats.Runes = len(c.runes)
+	c.runesMu.RUnlock()
+	c.reforgedRunesMu.RLock()
+	stats.ReforgedRunes = len(c.reforgedRunesByID)
+	c.reforgedRunesMu.RUnlock()
+	c.summonersMu.RLock()
+	stats.SummonerSpells = len(c.summoners)
+	c.summonersMu.RUnlock()
+	c.profileIconsMu.RLock()
+	stats.ProfileIcons = len(c.profileIcons)
+	c.profileIconsMu.RUnlock()
+	return stats
+}
+
+// SaveCache writes the cached champions, items, masteries, runes, summoner spells and profile icons together with the
+// current version and language as JSON files to the given directory. The directory is created if it does not exist.
+func (c *Client) SaveCache(dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	c.versionMu.RLock()
+	meta := cacheMeta{Version: c.version, Language: c.language}
+	c.versionMu.RUnlock()
+	if err := writeCacheFile(dir, cacheFileMeta, meta); err != nil {
+		return err
+	}
+	savers := []func(dir string) error{
+		c.saveChampionCache,
+		c.saveItemCache,
+		c.saveMasteryCache,
+		c.saveRuneCache,
+		c.saveReforgedRuneCache,
+		c.saveSummonerSpellCache,
+		c.saveProfileIconCache,
+	}
+	for _, save := range savers {
+		if err := save(dir); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LoadCache restores the caches from files written to the given directory by SaveCache. The caches are only restored
+// if the stored version and language match the current version and language of the client, otherwise an error is
+// returned. Restored data is served without any further requests.
+func (c *Client) LoadCache(dir string) error {
+	var meta cacheMeta
+	if err := readCacheFile(dir, cacheFileMeta, &meta); err != nil {
+		return err
+	}
+	c.versionMu.RLock()
+	version, language := c.version, c.language
+	c.versionMu.RUnlock()
+	if meta.Version != version || meta.Language != language {
+		return fmt.Errorf("cache for version %s and language %s does not match version %s and language %s",
+			meta.Version, meta.Language, version, language)
+	}
+	readers := []func(dir string) (func(now time.Time), error){
+		c.readChampionCache,
+		c.readItemCache,
+		c.readMasteryCache,
+		c.readRuneCache,
+		c.readReforgedRuneCache,
+		c.readSummonerSpellCache,
+		c.readProfileIconCache,
+	}
+	// all files are read before any cache is changed, so the caches are left as they are if any file is invalid
+	applies := make([]func(now time.Time), 0, len(readers))
+	for _, read := range readers {
+		apply, err := read(dir)
+		if err != nil {
+			return err
+		}
+		applies = append(applies, apply)
+	}
+	now := time.Now()
+	for _, apply := range applies {
+		apply(now)
+	}
+	return nil
+}
+
+func (c *Client) saveChampionCache(dir string) error {
+	c.championsMu.RLock()
+	defer c.championsMu.RUnlock()
+	return writeCacheFile(dir, cacheFileChampions, cachedChampions{
+		Complete:  atomic.LoadUint32(&c.getChampionsToggle) == 1,
+		Champions: c.championsByID,
+	})
+}
+
+// readChampionCache reads the champion cache file and returns a function which restores the champion cache from it
+func (c *Client) readChampionCache(dir string) (func(now time.Time), error) {
+	var champions cachedChampions
+	if err := readCacheFile(dir, cacheFileChampions, &champions); err != nil {
+		return nil, err
+	}
+	return func(now time.Time) {
+		if len(champions.Champions) < 1 {
+			return
+		}
+		c.championsMu.Lock()
+		defer c.championsMu.Unlock()
+		c.championsByID = map[string]ChampionDataExtended{}
+		c.championIDsByKey = map[string]string{}
+		for id, champion := range champions.Champions {
+			c.setChampion(id, champion)
+		}
+		if champions.Complete {
+			atomic.StoreUint32(&c.getChampionsToggle, 1)
+		}
+		c.championsUpdated = now
+	}, nil
+}
+
+func (c *Client) saveItemCache(dir string) error {
+	c.itemsMu.RLock()
+	defer c.itemsMu.RUnlock()
+	return writeCacheFile(dir, cacheFileItems, c.items)
+}
+
+// readItemCache reads the item cache file and returns a function which restores the item cache from it
+func (c *Client) readItemCache(dir string) (func(now time.Time), error) {
+	var items []Item
+	if err := readCacheFile(dir, cacheFileItems, &items); err != nil {
+		return nil, err
+	}
+	return func(time.Time) {
+		if len(items) < 1 {
+			return
+		}
+		itemsByID := make(map[string]Item, len(items))
+		for _, item := range items {
+			itemsByID[item.ID] = item
+		}
+		c.itemsMu.Lock()
+		defer c.itemsMu.Unlock()
+		c.setItems(itemsByID)
+	}, nil
+}
+
+func (c *Client) saveMasteryCache(dir string) error {
+	c.masteriesMu.RLock()
+	defer c.masteriesMu.RUnlock()
+	return writeCacheFile(dir, cacheFileMasteries, c.masteries)
+}
+
+// readMasteryCache reads the mastery cache file and returns a function which restores the mastery cache from it
+func (c *Client) readMasteryCache(dir string) (func(now time.Time), error) {
+	var masteries []Mastery
+	if err := readCacheFile(dir, cacheFileMasteries, &masteries); err != nil {
+		return nil, err
+	}
+	return func(now time.Time) {
+		if len(masteries) < 1 {
+			return
+		}
+		c.masteriesMu.Lock()
+		defer c.masteriesMu.Unlock()
+		c.masteries = masteries
+		c.masteriesUpdated = now
+	}, nil
+}
+
+func (c *Client) saveRuneCache(dir string) error {
+	c.runesMu.RLock()
+	defer c.runesMu.RUnlock()
+	return writeCacheFile(dir, cacheFileRunes, c.runes)
+}
+
+// readRuneCache reads the rune cache file and returns a function which restores the rune cache from it
+func (c *Client) readRuneCache(dir string) (func(now time.Time), error) {
+	var runes []Item
+	if err := readCacheFile(dir, cacheFileRunes, &runes); err != nil {
+		return nil, err
+	}
+	return func(now time.Time) {
+		if len(runes) < 1 {
+			return
+		}
+		c.runesMu.Lock()
+		defer c.runesMu.Unlock()
+		c.runes = runes
+		c.runesUpdated = now
+	}, nil
+}
+
+func (c *Client) saveReforgedRuneCache(dir string) error {
+	c.reforgedRunesMu.RLock()
+	defer c.reforgedRunesMu.RUnlock()
+	return writeCacheFile(dir, cacheFileReforgedRunes, c.reforgedRunes)
+}
+
+// readReforgedRuneCache reads the Runes Reforged cache file and returns a function which restores the Runes Reforged
+// cache from it
+func (c *Client) readReforgedRuneCache(dir string) (func(now time.Time), error) {
+	var reforgedRunes []RuneReforgedPath
+	if err := readCacheFile(dir, cacheFileReforgedRunes, &reforgedRunes); err != nil {
+		return nil, err
+	}
+	return func(time.Time) {
+		if len(reforgedRunes) < 1 {
+			return
+		}
+		c.reforgedRunesMu.Lock()
+		defer c.reforgedRunesMu.Unlock()
+		c.setReforgedRunes(reforgedRunes)
+	}, nil
+}
+
+func (c *Client) saveSummonerSpellCache(dir string) error {
+	c.summonersMu.RLock()
+	defer c.summonersMu.RUnlock()
+	return writeCacheFile(dir, cacheFileSummonerSpells, c.summoners)
+}
+
+// readSummonerSpellCache reads the summoner spell cache file and returns a function which restores the summoner spell
+// cache from it
+func (c *Client) readSummonerSpellCache(dir string) (func(now time.Time), error) {
+	var summonerSpells []SummonerSpell
+	if err := readCacheFile(dir, cacheFileSummonerSpells, &summonerSpells); err != nil {
+		return nil, err
+	}
+	return func(time.Time) {
+		if len(summonerSpells) < 1 {
+			return
+		}
+		summonerSpellsByID := make(map[string]SummonerSpell, len(summonerSpells))
+		for _, summonerSpell := range summonerSpells {
+			summonerSpellsByID[summonerSpell.ID] = summonerSpell
+		}
+		c.summonersMu.Lock()
+		defer c.summonersMu.Unlock()
+		c.setSummonerSpells(summonerSpellsByID)
+	}, nil
+}
+
+func (c *Client) saveProfileIconCache(dir string) error {
+	c.profileIconsMu.RLock()
+	defer c.profileIconsMu.RUnlock()
+	return writeCacheFile(dir, cacheFileProfileIcons, c.profileIcons)
+}
+
+// readProfileIconCache reads the profile icon cache file and returns a function which restores the profile icon cache
+// from it
+func (c *Client) readProfileIconCache(dir string) (func(now time.Time), error) {
+	var profileIcons []ProfileIcon
+	if err := readCacheFile(dir, cacheFileProfileIcons, &profileIcons); err != nil {
+		return nil, err
+	}
+	return func(time.Time) {
+		if len(profileIcons) < 1 {
+			return
+		}
+		c.profileIconsMu.Lock()
+		defer c.profileIconsMu.Unlock()
+		c.setProfileIcons(profileIcons)
+	}, nil
+}
+
+func writeCacheFile(dir, name string, object interface{}) error {
+	data, err := json.Marshal(object)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, name), data, 0644)
+}
+
+// readCacheFile decodes the given cache file into target. Missing files are ignored.
+func readCacheFile(dir, name string, target interface{}) error {
+	data, err := ioutil.ReadFile(filepath.Join(dir, name))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, target)
+}
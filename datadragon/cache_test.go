@@ -0,0 +1,137 @@
+package datadragon
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+func TestClient_SaveCache_LoadCache(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "golio")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	source := NewClient(endpointResponseDoer(map[string]interface{}{
+		"/champion.json": dataDragonResponse{Data: map[string]ChampionData{
+			"champion": {Name: "champion", Key: "1"},
+		}},
+		"/item.json":        dataDragonResponse{Data: map[string]Item{"item": {}}},
+		"/summoner.json":    dataDragonResponse{Data: map[string]SummonerSpell{"summoner": {ID: "summoner"}}},
+		"/profileicon.json": dataDragonResponse{Data: map[string]ProfileIcon{"icon": {ID: 1}}},
+		"/runesReforged.json": []RuneReforgedPath{
+			{ID: 8000, Slots: []RuneReforgedSlot{{Runes: []RuneReforged{{ID: 8005}}}}},
+		},
+	}), api.RegionEuropeWest, log.StandardLogger())
+	require.Nil(t, source.Preload(context.Background()))
+	require.Nil(t, source.SaveCache(dir))
+
+	failing := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusInternalServerError}, nil
+		},
+	}
+	c := NewClient(failing, api.RegionEuropeWest, log.StandardLogger())
+	require.Nil(t, c.LoadCache(dir))
+	champions, err := c.GetChampions()
+	assert.Nil(t, err)
+	assert.Equal(t, []ChampionData{{Name: "champion", Key: "1"}}, champions)
+	item, err := c.GetItem("item")
+	assert.Nil(t, err)
+	assert.Equal(t, Item{ID: "item"}, item)
+	summonerSpell, err := c.GetSummonerSpellByID("summoner")
+	assert.Nil(t, err)
+	assert.Equal(t, SummonerSpell{ID: "summoner"}, summonerSpell)
+	profileIcon, err := c.GetProfileIcon(1)
+	assert.Nil(t, err)
+	assert.Equal(t, ProfileIcon{ID: 1}, profileIcon)
+	reforgedRune, err := c.GetReforgedRune(8005)
+	assert.Nil(t, err)
+	assert.Equal(t, RuneReforged{ID: 8005}, reforgedRune)
+	_, err = c.GetMaps()
+	assert.Equal(t, api.ErrInternalServerError, err)
+}
+
+func TestClient_LoadCache(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "golio")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	source := NewClient(dataDragonResponseDoer(map[string]Item{"item": {}}), api.RegionEuropeWest,
+		log.StandardLogger())
+	require.Nil(t, source.SetVersion("9.10.1"))
+	require.Nil(t, source.SetLanguage(LanguageCodeUnitedStates))
+	_, err = source.GetItems()
+	require.Nil(t, err)
+	require.Nil(t, source.SaveCache(dir))
+	tests := []struct {
+		name    string
+		dir     string
+		version string
+		wantErr bool
+	}{
+		{
+			name:    "matching version",
+			dir:     dir,
+			version: "9.10.1",
+		},
+		{
+			name:    "different version",
+			dir:     dir,
+			version: "9.11.1",
+			wantErr: true,
+		},
+		{
+			name:    "missing directory",
+			dir:     dir + "/missing",
+			version: "9.10.1",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(mock.NewStatusMockDoer(http.StatusInternalServerError), api.RegionEuropeWest,
+				log.StandardLogger())
+			require.Nil(t, c.SetVersion(tt.version))
+			err := c.LoadCache(tt.dir)
+			assert.Equal(t, tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.Equal(t, []Item{{ID: "item"}}, c.items)
+			} else {
+				assert.Len(t, c.items, 0)
+			}
+		})
+	}
+}
+
+func TestClient_CacheStats(t *testing.T) {
+	t.Parallel()
+	c := NewClient(endpointResponseDoer(map[string]interface{}{
+		"/champion.json":    dataDragonResponse{Data: map[string]ChampionData{"a": {Name: "a"}, "b": {Name: "b"}}},
+		"/item.json":        dataDragonResponse{Data: map[string]Item{"item": {}}},
+		"/summoner.json":    dataDragonResponse{Data: map[string]SummonerSpell{"summoner": {}}},
+		"/profileicon.json": dataDragonResponse{Data: map[string]ProfileIcon{"icon": {}}},
+		"/runesReforged.json": []RuneReforgedPath{
+			{Slots: []RuneReforgedSlot{{Runes: []RuneReforged{{ID: 1}, {ID: 2}}}}},
+		},
+	}), api.RegionEuropeWest, log.StandardLogger())
+	assert.Equal(t, CacheStats{Version: "9.10.1", Language: LanguageCodeUnitedStates}, c.CacheStats())
+	require.Nil(t, c.Preload(context.Background()))
+	assert.Equal(t, CacheStats{
+		Version:        "9.10.1",
+		Language:       LanguageCodeUnitedStates,
+		Champions:      2,
+		Items:          1,
+		ReforgedRunes:  2,
+		SummonerSpells: 1,
+		ProfileIcons:   1,
+	}, c.CacheStats())
+}
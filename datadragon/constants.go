@@ -6,10 +6,27 @@ const (
 	dataDragonBaseURL        dataDragonURL = "ddragon.leagueoflegends.com"
 	dataDragonDataURLFormat                = dataDragonBaseURL + "/cdn/%s/data/%s"
 	dataDragonImageURLFormat               = dataDragonBaseURL + "/cdn/%s/img"
+	dataDragonStaticImageURL               = dataDragonBaseURL + "/cdn/img"
+)
+
+// URL formats which can be passed to Client.URL
+const (
+	// URLFormatBase is used for endpoints independent of version and language, e.g. "/api/versions.json"
+	URLFormatBase = dataDragonBaseURL
+	// URLFormatData is used for data files of the current version and language, e.g. "/champion.json"
+	URLFormatData = dataDragonDataURLFormat
+	// URLFormatImage is used for images of the current version, e.g. "/champion/Aatrox.png"
+	URLFormatImage = dataDragonImageURLFormat
+	// URLFormatStaticImage is used for images independent of the version, e.g. "/champion/splash/Aatrox_0.jpg"
+	URLFormatStaticImage = dataDragonStaticImageURL
 )
 
 type languageCode string
 
+// LanguageCode is the type of all language codes, it allows declaring variables and fields holding a language code
+// outside of this package
+type LanguageCode = languageCode
+
 // All possible language codes
 const (
 	LanguageCodeCzechRepublic            languageCode = "cs_CZ"
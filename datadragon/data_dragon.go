@@ -3,15 +3,26 @@
 package datadragon
 
 import (
+	"compress/gzip"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
 	"net/http"
+	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	log "github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/singleflight"
 
 	"github.com/KnutZuidema/golio/api"
 	"github.com/KnutZuidema/golio/internal"
@@ -21,8 +32,38 @@ const (
 	latestRuneAndMasteryVersion = "7.23.1"
 	fallbackVersion             = "9.10.1"
 	fallbackLanguage            = LanguageCodeUnitedStates
+	maxChampionLevel            = 18
+	// maxConcurrentRequests is the default number of requests which are sent concurrently when retrieving several
+	// files
+	maxConcurrentRequests = 8
 )
 
+// ErrNotFound is returned by all lookups of the client if no data exists for the given id, key or name. It is equal to
+// api.ErrNotFound, which is also returned if the Data Dragon service responds with 404. Some lookups wrap it in an
+// error naming the missing id, so it should be matched using errors.Is.
+var ErrNotFound = api.ErrNotFound
+
+// ErrLegacyRunesRemoved is returned when legacy runes or masteries are requested for a version after 7.23.1, the last
+// version they are available for. Use WithLegacyRuneVersion to request them for an older version.
+var ErrLegacyRunesRemoved = errors.New("runes and masteries are not available after version " +
+	latestRuneAndMasteryVersion)
+
+// RateLimitError is returned if the Data Dragon service responded with 429 Too Many Requests and specified when the
+// request may be sent again. It wraps api.ErrRateLimitExceeded.
+type RateLimitError struct {
+	// RetryAfter is the duration after which the request may be sent again
+	RetryAfter time.Duration
+}
+
+func (e RateLimitError) Error() string {
+	return fmt.Sprintf("%s, retry after %s", api.ErrRateLimitExceeded.Message, e.RetryAfter)
+}
+
+// Unwrap returns api.ErrRateLimitExceeded
+func (e RateLimitError) Unwrap() error {
+	return api.ErrRateLimitExceeded
+}
+
 var (
 	regionToRealmRegion = map[api.Region]string{
 		api.RegionEuropeWest:        "euw",
@@ -37,396 +78,2690 @@ var (
 		api.RegionRussia:            "ru",
 		api.RegionTurkey:            "tr",
 		api.RegionBrasil:            "br",
+		api.RegionPhilippines:       "ph",
+		api.RegionSingapore:         "sg",
+		api.RegionThailand:          "th",
+		api.RegionTaiwan:            "tw",
+		api.RegionVietnam:           "vn",
+		api.RegionMiddleEast:        "me",
 	}
 )
 
 // Client provides access to all data provided by the Data Dragon service
 type Client struct {
-	logger             log.FieldLogger
-	Version            string
-	Language           languageCode
-	client             internal.Doer
-	championsMu        sync.RWMutex
-	championsByName    map[string]ChampionDataExtended
-	getChampionsToggle uint32
-	profileIconsMu     sync.RWMutex
-	profileIcons       []ProfileIcon
-	itemsMu            sync.RWMutex
-	items              []Item
-	masteriesMu        sync.RWMutex
-	masteries          []Mastery
-	runesMu            sync.RWMutex
-	runes              []Item
-	summonersMu        sync.RWMutex
-	summoners          []SummonerSpell
-}
-
-// NewClient returns a new client for the Data Dragon service.
-func NewClient(client internal.Doer, region api.Region, logger log.FieldLogger) *Client {
-	c := &Client{
-		client:          client,
-		logger:          logger.WithField("client", "data dragon"),
-		championsByName: map[string]ChampionDataExtended{},
-	}
-	if err := c.init(regionToRealmRegion[region]); err != nil {
-		c.Version = fallbackVersion
-		c.Language = fallbackLanguage
-	}
-	return c
+	logger               log.FieldLogger
+	versionMu            sync.RWMutex
+	version              string
+	language             languageCode
+	client               internal.Doer
+	realmRegion          string
+	baseURL              string
+	legacyRuneVersion    string
+	statGoldValues       map[string]float64
+	languageFallback     languageCode
+	maxConcurrency       int
+	metricsHook          MetricsHook
+	retryAttempts        int
+	retryBackoff         time.Duration
+	headers              map[string]string
+	cacheTTL             time.Duration
+	requestGroup         singleflight.Group
+	responsesMu          sync.Mutex
+	responsesByURL       map[string]cachedResponse
+	versionUpdatedMu     sync.Mutex
+	versionUpdated       time.Time
+	versionPinned        bool
+	initMu               sync.Mutex
+	initialized          bool
+	versionsMu           sync.RWMutex
+	versions             []string
+	versionsUpdated      time.Time
+	languagesMu          sync.RWMutex
+	languages            []languageCode
+	languagesUpdated     time.Time
+	championsMu          sync.RWMutex
+	championsByID        map[string]ChampionDataExtended
+	championIDsByKey     map[string]string
+	getChampionsToggle   uint32
+	championsUpdated     time.Time
+	championGroup        singleflight.Group
+	skinsMu              sync.RWMutex
+	skinsByID            map[string]skinEntry
+	skinsUpdated         time.Time
+	profileIconsMu       sync.RWMutex
+	profileIcons         []ProfileIcon
+	profileIconsByID     map[int]ProfileIcon
+	profileIconsUpdated  time.Time
+	itemsMu              sync.RWMutex
+	items                []Item
+	itemsByID            map[string]Item
+	itemsUpdated         time.Time
+	masteriesMu          sync.RWMutex
+	masteries            []Mastery
+	masteryTrees         []MasteryTree
+	masteriesUpdated     time.Time
+	runesMu              sync.RWMutex
+	runes                []Item
+	runesUpdated         time.Time
+	reforgedRunesMu      sync.RWMutex
+	reforgedRunes        []RuneReforgedPath
+	reforgedRunesByID    map[int]RuneReforged
+	reforgedRunesUpdated time.Time
+	summonersMu          sync.RWMutex
+	summoners            []SummonerSpell
+	summonersByID        map[string]SummonerSpell
+	summonersByKey       map[string]SummonerSpell
+	summonersUpdated     time.Time
+	mapsMu               sync.RWMutex
+	maps                 []GameMap
+	mapsByID             map[int]GameMap
+	mapsUpdated          time.Time
+	challengesMu         sync.RWMutex
+	challenges           []Challenge
+	challengesUpdated    time.Time
+	tftChampionsMu       sync.RWMutex
+	tftChampions         []TFTChampion
+	tftChampionsUpdated  time.Time
+	tftItemsMu           sync.RWMutex
+	tftItems             []TFTItem
+	tftItemsUpdated      time.Time
+	tftTraitsMu          sync.RWMutex
+	tftTraits            []TFTTrait
+	tftTraitsUpdated     time.Time
+	tftAugmentsMu        sync.RWMutex
+	tftAugments          []TFTAugment
+	tftAugmentsUpdated   time.Time
+	tftQueuesMu          sync.RWMutex
+	tftQueues            []TFTQueue
+	tftQueuesUpdated     time.Time
+	tftRegaliaMu         sync.RWMutex
+	tftRegalia           []TFTRegalia
+	tftRegaliaUpdated    time.Time
+	tftArenasMu          sync.RWMutex
+	tftArenas            []TFTArena
+	tftArenasUpdated     time.Time
 }
 
-func (c *Client) init(region string) error {
-	var res struct {
-		Version  string `json:"v"`
-		Language string `json:"l"`
+// Option is used to alter the attributes of a client
+type Option func(*Client)
+
+// WithCacheTTL sets the duration after which cached data is considered stale. Stale data is retrieved again on the
+// next call of the respective getter, before which the current version of the region is checked again. Files are
+// requested conditionally using their ETag, so unchanged files are not downloaded again.
+// By default cached data never expires.
+func WithCacheTTL(d time.Duration) Option {
+	return func(c *Client) {
+		c.cacheTTL = d
 	}
-	response, err := c.doRequest(dataDragonBaseURL, fmt.Sprintf("/realms/%s.json", region))
-	if err != nil {
-		return err
+}
+
+// WithBaseURL sets the URL including the scheme which is used instead of https://ddragon.leagueoflegends.com for all
+// requests, e.g. to use a mirror of the Data Dragon service
+func WithBaseURL(url string) Option {
+	return func(c *Client) {
+		c.baseURL = strings.TrimSuffix(url, "/")
 	}
-	if response.Body == nil {
-		return fmt.Errorf("no response body")
+}
+
+// WithLogger sets the logger used by the client. Unlike the logger passed to NewClient the logger is used as is,
+// without adding any fields.
+func WithLogger(logger log.FieldLogger) Option {
+	return func(c *Client) {
+		c.logger = logger
 	}
-	if err := json.NewDecoder(response.Body).Decode(&res); err != nil {
-		return err
+}
+
+// WithoutLogging disables all logging of the client
+func WithoutLogging() Option {
+	return WithLogger(discardLogger())
+}
+
+// WithRetry enables retrying requests which failed because of network errors, rate limiting or server errors. Every
+// request is sent at most the given number of times. The time between attempts starts at backoff and doubles after
+// every attempt. If a Retry-After header is present in a rate limited response the specified time is waited instead,
+// unless it is longer than the time waited before the last attempt, in which case the RateLimitError is returned.
+func WithRetry(attempts int, backoff time.Duration) Option {
+	return func(c *Client) {
+		c.retryAttempts = attempts
+		c.retryBackoff = backoff
 	}
-	c.Version = res.Version
-	c.Language = languageCode(res.Language)
-	return nil
 }
 
-// GetChampions returns all existing champions
-func (c *Client) GetChampions() ([]ChampionData, error) {
-	unlock, toggle := internal.RWLockToggle(&c.championsMu)
-	defer unlock()
-	if atomic.CompareAndSwapUint32(&c.getChampionsToggle, 0, 1) {
-		toggle()
-		var champions map[string]ChampionData
-		if err := c.getInto("/champion.json", &champions); err != nil {
-			return nil, err
-		}
-		for _, champion := range champions {
-			data := ChampionDataExtended{ChampionData: champion}
-			c.championsByName[champion.Name] = data
-		}
+// MetricsHook is used to observe the requests made by a client
+type MetricsHook interface {
+	// ObserveRequest is called after every request to the Data Dragon service with the requested endpoint, e.g.
+	// "/champion.json", the duration until the response was received, the status code of the response and the error
+	// returned for the request, if any. The status code is 0 if no response was received.
+	ObserveRequest(endpoint string, duration time.Duration, statusCode int, err error)
+}
+
+// WithMetricsHook sets the hook which is called for every request made by the client
+func WithMetricsHook(hook MetricsHook) Option {
+	return func(c *Client) {
+		c.metricsHook = hook
 	}
-	res := make([]ChampionData, 0, len(c.championsByName))
-	for _, champion := range c.championsByName {
-		res = append(res, champion.ChampionData)
+}
+
+// WithTimeout sets the duration after which a request is aborted, including reading the response body. Each attempt of
+// a retried request has its own deadline. By default requests are only aborted if the context is cancelled or the
+// given Doer times out.
+func WithTimeout(d time.Duration) Option {
+	return func(c *Client) {
+		c.client = timeoutDoer{doer: c.client, timeout: d}
 	}
-	return res, nil
 }
 
-// GetChampionByID returns information about the champion with the given id
-func (c *Client) GetChampionByID(id string) (ChampionDataExtended, error) {
-	champions, err := c.GetChampions()
-	if err != nil {
-		return ChampionDataExtended{}, err
+// timeoutDoer is a Doer which sends every request with a deadline
+type timeoutDoer struct {
+	doer    internal.Doer
+	timeout time.Duration
+}
+
+func (d timeoutDoer) Do(request *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(request.Context(), d.timeout)
+	response, err := d.doer.Do(request.WithContext(ctx))
+	if err != nil || response.Body == nil {
+		cancel()
+		return response, err
 	}
-	for _, champion := range champions {
-		if champion.ID == id {
-			return c.GetChampion(champion.Name)
-		}
+	response.Body = cancelReadCloser{ReadCloser: response.Body, cancel: cancel}
+	return response, nil
+}
+
+// cancelReadCloser cancels the context of a request when the response body is closed
+type cancelReadCloser struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (r cancelReadCloser) Close() error {
+	defer r.cancel()
+	return r.ReadCloser.Close()
+}
+
+// WithLegacyRuneVersion sets the version used for the endpoints of the legacy rune and mastery systems, e.g. "7.10.1"
+// for historical analysis. By default the current version is used, which fails with ErrLegacyRunesRemoved for
+// versions after the systems were removed.
+func WithLegacyRuneVersion(version string) Option {
+	return func(c *Client) {
+		c.legacyRuneVersion = version
 	}
-	return ChampionDataExtended{}, api.ErrNotFound
 }
 
-// GetChampion returns information about the champion with the given name
-func (c *Client) GetChampion(name string) (ChampionDataExtended, error) {
-	unlock, toggle := internal.RWLockToggle(&c.championsMu)
-	defer unlock()
-	champion, ok := c.championsByName[name]
-	if !ok || champion.Lore == "" {
-		toggle()
-		var data map[string]ChampionDataExtended
-		if err := c.getInto(fmt.Sprintf("/champion/%s.json", name), &data); err != nil {
-			return ChampionDataExtended{}, err
-		}
-		champion, ok = data[name]
-		if !ok {
-			return ChampionDataExtended{}, api.ErrNotFound
+// WithHeaders sets headers which are added to every request, e.g. a User-Agent required by a mirror
+func WithHeaders(headers map[string]string) Option {
+	return func(c *Client) {
+		c.headers = make(map[string]string, len(headers))
+		for key, value := range headers {
+			c.headers[key] = value
 		}
-		c.championsByName[name] = champion
 	}
-	return champion, nil
 }
 
-// GetProfileIcons returns all existing profile icons
-func (c *Client) GetProfileIcons() ([]ProfileIcon, error) {
-	unlock, toggle := internal.RWLockToggle(&c.profileIconsMu)
-	defer unlock()
-	if len(c.profileIcons) < 1 {
-		toggle()
-		var res map[string]ProfileIcon
-		if err := c.getInto("/profileicon.json", &res); err != nil {
-			return nil, err
-		}
-		c.profileIcons = make([]ProfileIcon, 0, len(res))
-		for _, profileIcon := range res {
-			c.profileIcons = append(c.profileIcons, profileIcon)
+// WithStatGoldValues sets the gold values of the item stats used by ItemGoldEfficiency by the stat key of Data Dragon,
+// e.g. to update them after a patch changed the cost of the basic items
+func WithStatGoldValues(values map[string]float64) Option {
+	return func(c *Client) {
+		c.statGoldValues = make(map[string]float64, len(values))
+		for key, value := range values {
+			c.statGoldValues[key] = value
 		}
 	}
-	res := make([]ProfileIcon, len(c.profileIcons))
-	copy(res, c.profileIcons)
-	return res, nil
 }
 
-// GetProfileIcon return information about the profile icon with the given id
-func (c *Client) GetProfileIcon(id int) (ProfileIcon, error) {
-	icons, err := c.GetProfileIcons()
-	if err != nil {
-		return ProfileIcon{}, err
-	}
-	for _, icon := range icons {
-		if icon.ID == id {
-			return icon, nil
-		}
+// WithLanguageFallback sets a language whose strings are used for champions and items if their name, title, blurb,
+// lore or description is empty in the language of the client, e.g. LanguageCodeUnitedStates for locales with missing
+// translations. The data is only requested in the fallback language if any string is missing.
+func WithLanguageFallback(fallback languageCode) Option {
+	return func(c *Client) {
+		c.languageFallback = fallback
 	}
-	return ProfileIcon{}, api.ErrNotFound
 }
 
-// GetItems returns all existing items
-func (c *Client) GetItems() ([]Item, error) {
-	unlock, toggle := internal.RWLockToggle(&c.itemsMu)
-	defer unlock()
-	if len(c.items) < 1 {
-		toggle()
-		var res map[string]Item
-		if err := c.getInto("/item.json", &res); err != nil {
-			return nil, err
-		}
-		c.items = make([]Item, 0, len(res))
-		for id, item := range res {
-			item.ID = id
-			c.items = append(c.items, item)
-		}
+// WithMaxConcurrency sets the maximum number of requests which are sent concurrently by methods retrieving several
+// files, e.g. GetAllChampionsExtended and Preload. The default of 8 is used if n is not positive.
+func WithMaxConcurrency(n int) Option {
+	return func(c *Client) {
+		c.maxConcurrency = n
 	}
-	res := make([]Item, len(c.items))
-	copy(res, c.items)
-	return res, nil
 }
 
-// GetItem return information about the item with the given id
-func (c *Client) GetItem(id string) (Item, error) {
-	items, err := c.GetItems()
-	if err != nil {
-		return Item{}, err
+// RealmRegionFor returns the name of the realm of the given region as used by the realm files of Data Dragon, e.g.
+// "euw" for api.RegionEuropeWest. False is returned for unknown regions.
+func RealmRegionFor(region api.Region) (string, bool) {
+	realmRegion, ok := regionToRealmRegion[region]
+	return realmRegion, ok
+}
+
+// NewClient returns a new client for the Data Dragon service. If logger is nil logging is disabled.
+func NewClient(client internal.Doer, region api.Region, logger log.FieldLogger, options ...Option) *Client {
+	c := newClient(client, logger, options...)
+	realmRegion, ok := regionToRealmRegion[region]
+	if !ok {
+		realmRegion = regionToRealmRegion[api.RegionNorthAmerica]
+		c.logger.WithField("region", region).Warnf("unknown region, using realm %s instead", realmRegion)
 	}
-	for _, item := range items {
-		if item.ID == id {
-			return item, nil
-		}
+	c.realmRegion = realmRegion
+	if err := c.init(context.Background(), c.realmRegion); err != nil {
+		c.version = fallbackVersion
+		c.language = fallbackLanguage
+	} else {
+		c.initialized = true
 	}
-	return Item{}, api.ErrNotFound
+	c.versionUpdated = time.Now()
+	return c
 }
 
-// GetMasteries returns all existing masteries. Masteries were removed in patch 7.23.1. If any version higher than that
-// is specified the last available version will be used instead.
-func (c *Client) GetMasteries() ([]Mastery, error) {
-	unlock, toggle := internal.RWLockToggle(&c.masteriesMu)
-	defer unlock()
-	if len(c.masteries) < 1 {
-		toggle()
-		var res map[string]Mastery
-		if err := c.getInto("/mastery.json", &res); err != nil {
-			return nil, err
-		}
-		c.masteries = make([]Mastery, 0, len(res))
-		for _, mastery := range res {
-			c.masteries = append(c.masteries, mastery)
-		}
+// NewClientWithVersion returns a new client for the Data Dragon service which uses the given version and language
+// without requesting the realm of a region. The version has to be of the form X.Y.Z, e.g. "9.10.1", and is never
+// refreshed, even if a cache TTL is set. If logger is nil logging is disabled.
+func NewClientWithVersion(client internal.Doer, version string, language languageCode, logger log.FieldLogger,
+	options ...Option) (*Client, error) {
+	if !isValidVersion(version) {
+		return nil, fmt.Errorf("invalid version %s", version)
 	}
-	res := make([]Mastery, len(c.masteries))
-	copy(res, c.masteries)
-	return res, nil
+	c := newClient(client, logger, options...)
+	c.version = version
+	c.language = language
+	c.versionPinned = true
+	c.initialized = true
+	c.versionUpdated = time.Now()
+	return c, nil
 }
 
-// GetMastery returns information about the mastery with the given id
-func (c *Client) GetMastery(id int) (Mastery, error) {
-	masteries, err := c.GetMasteries()
-	if err != nil {
-		return Mastery{}, err
+// newClient returns a new client with the given options applied but without a version and language
+func newClient(client internal.Doer, logger log.FieldLogger, options ...Option) *Client {
+	c := &Client{
+		client:           client,
+		championsByID:    map[string]ChampionDataExtended{},
+		championIDsByKey: map[string]string{},
+		responsesByURL:   map[string]cachedResponse{},
 	}
-	for _, mastery := range masteries {
-		if mastery.ID == id {
-			return mastery, nil
-		}
+	if logger == nil {
+		c.logger = discardLogger()
+	} else {
+		c.logger = logger.WithField("client", "data dragon")
 	}
-	return Mastery{}, api.ErrNotFound
+	for _, opt := range options {
+		opt(c)
+	}
+	return c
 }
 
-// GetRunes returns all existing runes. Runes were removed in patch 7.23.1. If any version higher than that
-// is specified the last available version will be used instead.
-func (c *Client) GetRunes() ([]Item, error) {
-	unlock, toggle := internal.RWLockToggle(&c.runesMu)
-	defer unlock()
-	if len(c.runes) < 1 {
-		toggle()
-		var res map[string]Item
-		if err := c.getInto("/rune.json", &res); err != nil {
-			return nil, err
-		}
-		c.runes = make([]Item, 0, len(res))
-		for id, runeItem := range res {
-			runeItem.ID = id
-			c.runes = append(c.runes, runeItem)
-		}
+// discardLogger returns a logger which discards all entries
+func discardLogger() log.FieldLogger {
+	logger := log.New()
+	logger.Out = ioutil.Discard
+	logger.Level = log.PanicLevel
+	return logger
+}
+
+func (c *Client) init(ctx context.Context, region string) error {
+	version, language, err := c.getRealm(ctx, region)
+	if err != nil {
+		return err
 	}
-	res := make([]Item, len(c.runes))
-	copy(res, c.runes)
-	return res, nil
+	c.versionMu.Lock()
+	c.version = version
+	c.language = language
+	c.versionMu.Unlock()
+	return nil
 }
 
-// GetRune returns information about the rune with the given id
-func (c *Client) GetRune(id string) (Item, error) {
-	runes, err := c.GetRunes()
+// getRealm returns the current version and default language of the given realm region
+func (c *Client) getRealm(ctx context.Context, region string) (string, languageCode, error) {
+	var res struct {
+		Version  string `json:"v"`
+		Language string `json:"l"`
+	}
+	response, err := c.doRequest(ctx, dataDragonBaseURL, fmt.Sprintf("/realms/%s.json", region))
 	if err != nil {
-		return Item{}, err
+		return "", "", err
 	}
-	for _, r := range runes {
-		if r.ID == id {
-			return r, nil
-		}
+	if response.Body == nil {
+		return "", "", fmt.Errorf("no response body")
 	}
-	return Item{}, api.ErrNotFound
+	if err := json.NewDecoder(response.Body).Decode(&res); err != nil {
+		return "", "", err
+	}
+	if res.Version == "" {
+		return "", "", fmt.Errorf("no version for realm %s", region)
+	}
+	return res.Version, languageCode(res.Language), nil
 }
 
-// GetSummonerSpells returns all existing summoner spells
-func (c *Client) GetSummonerSpells() ([]SummonerSpell, error) {
-	unlock, toggle := internal.RWLockToggle(&c.summonersMu)
+// expired reports whether data cached at the given time is stale
+func (c *Client) expired(updated time.Time) bool {
+	return c.cacheTTL > 0 && time.Since(updated) > c.cacheTTL
+}
+
+// getCached fills the cache guarded by mu by calling fetch if cached reports it as empty or it was last updated longer
+// than the cache TTL ago. read is called afterwards to copy the cache while the lock is still held.
+func (c *Client) getCached(mu *sync.RWMutex, updated *time.Time, cached func() bool, fetch func() error,
+	read func()) error {
+	unlock, toggle := internal.RWLockToggle(mu)
 	defer unlock()
-	if len(c.summoners) < 1 {
+	if !cached() || c.expired(*updated) {
 		toggle()
-		var res map[string]SummonerSpell
-		if err := c.getInto("/summoner.json", &res); err != nil {
-			return nil, err
-		}
-		c.summoners = make([]SummonerSpell, 0, len(res))
-		for _, summoner := range res {
-			c.summoners = append(c.summoners, summoner)
+		if !cached() || c.expired(*updated) {
+			if err := fetch(); err != nil {
+				return err
+			}
+			*updated = time.Now()
 		}
 	}
-	res := make([]SummonerSpell, len(c.summoners))
-	copy(res, c.summoners)
-	return res, nil
+	read()
+	return nil
 }
 
-// GetSummonerSpell returns information about the summoner spell with the given id
-func (c *Client) GetSummonerSpell(id string) (SummonerSpell, error) {
-	summonerSpells, err := c.GetSummonerSpells()
-	if err != nil {
-		return SummonerSpell{}, err
+// EnsureInitialized requests the version and language of the realm of the client again if the initial request in
+// NewClient failed and the client is still using the fallback version. Once the realm was retrieved successfully
+// further calls return immediately. All caches are cleared if the version or language changed.
+func (c *Client) EnsureInitialized(ctx context.Context) error {
+	c.initMu.Lock()
+	defer c.initMu.Unlock()
+	if c.initialized {
+		return nil
 	}
-	for _, summonerSpell := range summonerSpells {
-		if summonerSpell.ID == id {
-			return summonerSpell, nil
-		}
+	c.versionMu.RLock()
+	version, language := c.version, c.language
+	c.versionMu.RUnlock()
+	if err := c.init(ctx, c.realmRegion); err != nil {
+		return err
 	}
-	return SummonerSpell{}, api.ErrNotFound
+	c.initialized = true
+	c.versionMu.RLock()
+	changed := version != c.version || language != c.language
+	c.versionMu.RUnlock()
+	if changed {
+		c.ClearCaches()
+	}
+	return nil
 }
 
-// ClearCaches resets all caches of the data dragon client
-func (c *Client) ClearCaches() {
-	c.championsMu.Lock()
-	c.championsByName = map[string]ChampionDataExtended{}
-	atomic.StoreUint32(&c.getChampionsToggle, 0)
-	c.championsMu.Unlock()
-	c.masteriesMu.Lock()
-	c.masteries = []Mastery{}
-	c.masteriesMu.Unlock()
-	c.profileIconsMu.Lock()
-	c.profileIcons = []ProfileIcon{}
-	c.profileIconsMu.Unlock()
-	c.itemsMu.Lock()
-	c.items = []Item{}
-	c.itemsMu.Unlock()
-	c.summonersMu.Lock()
-	c.summoners = []SummonerSpell{}
-	c.summonersMu.Unlock()
-	c.runesMu.Lock()
-	c.runes = []Item{}
-	c.runesMu.Unlock()
+// refreshVersionIfExpired checks the current version of the region again if the cache TTL has passed since it was
+// last checked. The caller must not hold any cache lock.
+func (c *Client) refreshVersionIfExpired(ctx context.Context) {
+	c.versionUpdatedMu.Lock()
+	defer c.versionUpdatedMu.Unlock()
+	if c.versionPinned || !c.expired(c.versionUpdated) {
+		return
+	}
+	c.versionUpdated = time.Now()
+	if _, err := c.RefreshVersionCtx(ctx); err != nil {
+		c.logger.WithField("region", c.realmRegion).Warn(err)
+	}
 }
 
-func (c *Client) getInto(endpoint string, target interface{}) error {
-	response, err := c.doRequest(dataDragonDataURLFormat, endpoint)
+// RefreshVersion retrieves the current version of the region of the client again. If the version changed it is used
+// for all further requests and all caches are cleared. The returned value reports whether the version changed.
+// It is safe to call RefreshVersion while other methods of the client are in use.
+func (c *Client) RefreshVersion() (bool, error) {
+	return c.RefreshVersionCtx(context.Background())
+}
+
+// RefreshVersionCtx is like RefreshVersion but uses the given context for the request
+func (c *Client) RefreshVersionCtx(ctx context.Context) (bool, error) {
+	version, _, err := c.getRealm(ctx, c.realmRegion)
 	if err != nil {
-		return err
+		return false, err
 	}
-	var ddResponse dataDragonResponse
-	if err = json.NewDecoder(response.Body).Decode(&ddResponse); err != nil {
-		return err
+	c.versionMu.Lock()
+	changed := version != c.version
+	c.version = version
+	c.versionMu.Unlock()
+	if changed {
+		c.ClearCaches()
 	}
-	// this can not return an error. the error would have been returned during the above decode already
-	data, _ := json.Marshal(ddResponse.Data)
-	return json.Unmarshal(data, &target)
+	return changed, nil
 }
 
-func (c *Client) doRequest(format dataDragonURL, endpoint string) (*http.Response, error) {
-	request, err := c.newRequest(format, endpoint)
-	if err != nil {
-		return nil, err
+// GetVersionForRegion returns the current version of the realm of the given region, e.g. "9.10.1". The version of the
+// client is not changed.
+func (c *Client) GetVersionForRegion(region api.Region) (string, error) {
+	return c.GetVersionForRegionCtx(context.Background(), region)
+}
+
+// GetVersionForRegionCtx is like GetVersionForRegion but uses the given context for all requests
+func (c *Client) GetVersionForRegionCtx(ctx context.Context, region api.Region) (string, error) {
+	realmRegion, ok := regionToRealmRegion[region]
+	if !ok {
+		return "", fmt.Errorf("unknown region %s", region)
 	}
-	response, err := c.client.Do(request)
-	if err != nil {
-		return nil, err
+	version, _, err := c.getRealm(ctx, realmRegion)
+	return version, err
+}
+
+// GetVersion returns the version used for all requests, e.g. "9.10.1"
+func (c *Client) GetVersion() string {
+	c.versionMu.RLock()
+	defer c.versionMu.RUnlock()
+	return c.version
+}
+
+// GetLanguage returns the language used for all requests, e.g. "en_US"
+func (c *Client) GetLanguage() languageCode {
+	c.versionMu.RLock()
+	defer c.versionMu.RUnlock()
+	return c.language
+}
+
+// SetVersion sets the version used for all further requests. The version has to be of the form X.Y.Z, e.g. "9.10.1".
+// If the version differs from the current one all caches are cleared. Like the version of NewClientWithVersion it is
+// never refreshed, even if a cache TTL is set.
+func (c *Client) SetVersion(version string) error {
+	if !isValidVersion(version) {
+		return fmt.Errorf("invalid version %s", version)
 	}
-	if response.StatusCode < 200 || response.StatusCode > 299 {
-		var err error
-		err, ok := api.StatusToError[response.StatusCode]
-		if !ok {
-			err = api.Error{
-				Message:    "unknown error reason",
-				StatusCode: response.StatusCode,
-			}
-		}
-		return nil, err
+	c.versionUpdatedMu.Lock()
+	c.versionPinned = true
+	c.versionUpdatedMu.Unlock()
+	c.versionMu.Lock()
+	changed := version != c.version
+	c.version = version
+	c.versionMu.Unlock()
+	if changed {
+		c.ClearCaches()
 	}
-	return response, nil
+	return nil
 }
 
-func (c *Client) newRequest(format dataDragonURL, endpoint string) (*http.Request, error) {
-	var version string
-	if (strings.Contains(endpoint, "rune") || strings.Contains(endpoint, "mastery")) &&
-		versionGreaterThan(c.Version, latestRuneAndMasteryVersion) {
-		version = latestRuneAndMasteryVersion
-	} else {
-		version = c.Version
-	}
-	var url string
-	switch format {
-	case dataDragonDataURLFormat:
-		url = fmt.Sprintf(string(format), version, c.Language)
-	case dataDragonImageURLFormat:
-		url = fmt.Sprintf(string(format), version)
-	default:
-		url = string(format)
+// SetLanguage sets the language used for all further requests. The language has to be one of LanguageCodes.
+// If the language differs from the current one all caches are cleared.
+func (c *Client) SetLanguage(code languageCode) error {
+	if !isValidLanguageCode(code) {
+		return fmt.Errorf("invalid language code %s", code)
 	}
-	url = "https://" + url + endpoint
-	request, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, err
+	c.versionMu.Lock()
+	changed := code != c.language
+	c.language = code
+	c.versionMu.Unlock()
+	if changed {
+		c.ClearCaches()
 	}
-	return request, nil
+	return nil
 }
 
-func versionGreaterThan(v1, v2 string) bool {
-	v1Split := strings.Split(v1, ".")
-	v2Split := strings.Split(v2, ".")
-	for i := 0; i < len(v1Split) && i < len(v2Split); i++ {
-		int1, err := strconv.Atoi(v1Split[i])
+// GetVersions returns all versions available on Data Dragon. The versions are ordered from newest to oldest.
+func (c *Client) GetVersions() ([]string, error) {
+	return c.GetVersionsCtx(context.Background())
+}
+
+// GetVersionsCtx is like GetVersions but uses the given context for all requests
+func (c *Client) GetVersionsCtx(ctx context.Context) ([]string, error) {
+	var versions, res []string
+	err := c.getCachedList(ctx, "/api/versions.json", &versions, &c.versionsMu, &c.versionsUpdated,
+		func() bool { return len(c.versions) > 0 },
+		func() { c.versions = versions },
+		func() { res = append([]string{}, c.versions...) })
+	return res, err
+}
+
+// GetLanguages returns the language codes of all languages available on Data Dragon
+func (c *Client) GetLanguages() ([]languageCode, error) {
+	return c.GetLanguagesCtx(context.Background())
+}
+
+// GetLanguagesCtx is like GetLanguages but uses the given context for all requests
+func (c *Client) GetLanguagesCtx(ctx context.Context) ([]languageCode, error) {
+	var languages, res []languageCode
+	err := c.getCachedList(ctx, "/cdn/languages.json", &languages, &c.languagesMu, &c.languagesUpdated,
+		func() bool { return len(c.languages) > 0 },
+		func() { c.languages = languages },
+		func() { res = append([]languageCode{}, c.languages...) })
+	return res, err
+}
+
+// getCachedList fills the cache guarded by mu if it is empty or has expired by decoding the JSON list at the given
+// endpoint of the Data Dragon base URL into target and calling store afterwards. read copies the cache and is called
+// with the lock held.
+func (c *Client) getCachedList(ctx context.Context, endpoint string, target interface{}, mu *sync.RWMutex,
+	updated *time.Time, cached func() bool, store func(), read func()) error {
+	return c.getCached(mu, updated, cached, func() error {
+		response, err := c.doRequest(ctx, dataDragonBaseURL, endpoint)
 		if err != nil {
-			return false
+			return err
 		}
-		int2, err := strconv.Atoi(v2Split[i])
-		if err != nil {
-			return false
+		defer response.Body.Close()
+		if err := json.NewDecoder(response.Body).Decode(target); err != nil {
+			return err
 		}
-		if int1 > int2 {
-			return true
+		store()
+		return nil
+	}, read)
+}
+
+// GetChampions returns all existing champions
+func (c *Client) GetChampions() ([]ChampionData, error) {
+	return c.GetChampionsCtx(context.Background())
+}
+
+// GetChampionsCtx is like GetChampions but uses the given context for all requests
+func (c *Client) GetChampionsCtx(ctx context.Context) ([]ChampionData, error) {
+	c.refreshVersionIfExpired(ctx)
+	var res []ChampionData
+	err := c.ensureChampions(ctx, func() {
+		res = make([]ChampionData, 0, len(c.championsByID))
+		for _, champion := range c.championsByID {
+			res = append(res, champion.ChampionData)
 		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return false
+	return res, nil
+}
+
+// GetChampionCount returns the number of existing champions
+func (c *Client) GetChampionCount() (int, error) {
+	return c.GetChampionCountCtx(context.Background())
+}
+
+// GetChampionCountCtx is like GetChampionCount but uses the given context for all requests
+func (c *Client) GetChampionCountCtx(ctx context.Context) (int, error) {
+	c.refreshVersionIfExpired(ctx)
+	var count int
+	err := c.ensureChampions(ctx, func() {
+		count = len(c.championsByID)
+	})
+	return count, err
+}
+
+// GetChampionsByID returns all existing champions by their numeric key, e.g. "266" for Aatrox, as it is used by the
+// Riot API
+func (c *Client) GetChampionsByID() (map[string]ChampionData, error) {
+	return c.GetChampionsByIDCtx(context.Background())
+}
+
+// GetChampionsByIDCtx is like GetChampionsByID but uses the given context for all requests
+func (c *Client) GetChampionsByIDCtx(ctx context.Context) (map[string]ChampionData, error) {
+	champions, err := c.GetChampionsByNameCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string]ChampionData, len(champions))
+	for _, champion := range champions {
+		res[champion.Key] = champion
+	}
+	return res, nil
+}
+
+// GetChampionsByName returns all existing champions by their name
+func (c *Client) GetChampionsByName() (map[string]ChampionData, error) {
+	return c.GetChampionsByNameCtx(context.Background())
+}
+
+// GetChampionsByNameCtx is like GetChampionsByName but uses the given context for all requests
+func (c *Client) GetChampionsByNameCtx(ctx context.Context) (map[string]ChampionData, error) {
+	c.refreshVersionIfExpired(ctx)
+	var res map[string]ChampionData
+	err := c.ensureChampions(ctx, func() {
+		res = make(map[string]ChampionData, len(c.championsByID))
+		for _, champion := range c.championsByID {
+			res[champion.Name] = champion.ChampionData
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// EachChampion calls fn for every existing champion in the order of their ids without copying the list of all
+// champions. Iteration stops at the first error returned by fn or when the context is cancelled, the error is
+// returned. The client may be used within fn.
+func (c *Client) EachChampion(ctx context.Context, fn func(ChampionData) error) error {
+	c.refreshVersionIfExpired(ctx)
+	var ids []string
+	err := c.ensureChampions(ctx, func() {
+		ids = make([]string, 0, len(c.championsByID))
+		for id := range c.championsByID {
+			ids = append(ids, id)
+		}
+	})
+	if err != nil {
+		return err
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		c.championsMu.RLock()
+		champion, ok := c.championsByID[id]
+		c.championsMu.RUnlock()
+		// the champion may have been removed by a concurrent refresh of the caches
+		if !ok {
+			continue
+		}
+		if err := fn(champion.ChampionData); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetChampionByID returns information about the champion with the given id. The id is the numeric key of the
+// champion, e.g. "266" for Aatrox, as it is used by the Riot API.
+func (c *Client) GetChampionByID(id string) (ChampionDataExtended, error) {
+	return c.GetChampionByIDCtx(context.Background(), id)
+}
+
+// GetChampionByIDCtx is like GetChampionByID but uses the given context for all requests
+func (c *Client) GetChampionByIDCtx(ctx context.Context, id string) (ChampionDataExtended, error) {
+	c.refreshVersionIfExpired(ctx)
+	var championID string
+	var ok bool
+	err := c.ensureChampions(ctx, func() {
+		championID, ok = c.championIDsByKey[id]
+	})
+	if err != nil {
+		return ChampionDataExtended{}, err
+	}
+	if !ok {
+		return ChampionDataExtended{}, fmt.Errorf("no champion with id %s: %w", id, api.ErrNotFound)
+	}
+	return c.GetChampionCtx(ctx, championID)
+}
+
+// GetChampionByIntID is like GetChampionByID but takes the numeric key as an int, e.g. the champion id of a match
+// participant
+func (c *Client) GetChampionByIntID(id int) (ChampionDataExtended, error) {
+	return c.GetChampionByIntIDCtx(context.Background(), id)
+}
+
+// GetChampionByIntIDCtx is like GetChampionByIntID but uses the given context for all requests
+func (c *Client) GetChampionByIntIDCtx(ctx context.Context, id int) (ChampionDataExtended, error) {
+	return c.GetChampionByIDCtx(ctx, strconv.Itoa(id))
+}
+
+// GetChampionByLocalizedName returns information about the champion with the given name in the language of the client,
+// e.g. "오공" for Wukong if the language is LanguageCodeKorea. Names are compared case-insensitively.
+func (c *Client) GetChampionByLocalizedName(name string) (ChampionDataExtended, error) {
+	return c.GetChampionByLocalizedNameCtx(context.Background(), name)
+}
+
+// GetChampionByLocalizedNameCtx is like GetChampionByLocalizedName but uses the given context for all requests
+func (c *Client) GetChampionByLocalizedNameCtx(ctx context.Context, name string) (ChampionDataExtended, error) {
+	c.refreshVersionIfExpired(ctx)
+	var championID string
+	err := c.ensureChampions(ctx, func() {
+		for id, champion := range c.championsByID {
+			if strings.EqualFold(champion.Name, name) {
+				championID = id
+				break
+			}
+		}
+	})
+	if err != nil {
+		return ChampionDataExtended{}, err
+	}
+	if championID == "" {
+		return ChampionDataExtended{}, fmt.Errorf("no champion with localized name %q: %w", name, api.ErrNotFound)
+	}
+	return c.GetChampionCtx(ctx, championID)
+}
+
+// ensureChampions retrieves the list of all champions if it is not cached yet or has expired. read is called
+// afterwards while championsMu is still locked.
+func (c *Client) ensureChampions(ctx context.Context, read func()) error {
+	return c.getCached(&c.championsMu, &c.championsUpdated, func() bool {
+		return atomic.LoadUint32(&c.getChampionsToggle) == 1
+	}, func() error {
+		return c.fetchChampions(ctx)
+	}, read)
+}
+
+// fetchChampions retrieves the list of all champions and populates the champion caches.
+// The caller must hold the write lock of championsMu.
+func (c *Client) fetchChampions(ctx context.Context) error {
+	var champions map[string]ChampionData
+	if err := c.getInto(ctx, "/champion.json", &champions); err != nil {
+		return err
+	}
+	if c.useLanguageFallback() && championsMissingStrings(champions) {
+		var fallback map[string]ChampionData
+		if err := c.getFallbackInto(ctx, "/champion.json", &fallback); err != nil {
+			c.logger.WithField("language", c.languageFallback).Warn(err)
+		}
+		for id, champion := range champions {
+			fillChampionStrings(&champion, fallback[id])
+			champions[id] = champion
+		}
+	}
+	if c.expired(c.championsUpdated) {
+		c.championsByID = map[string]ChampionDataExtended{}
+		c.championIDsByKey = map[string]string{}
+	}
+	for id, champion := range champions {
+		c.setChampion(id, ChampionDataExtended{ChampionData: champion})
+	}
+	atomic.StoreUint32(&c.getChampionsToggle, 1)
+	c.championsUpdated = time.Now()
+	return nil
+}
+
+// championsMissingStrings reports whether any of the champions is missing a localized string
+func championsMissingStrings(champions map[string]ChampionData) bool {
+	for _, champion := range champions {
+		if championMissingStrings(champion) {
+			return true
+		}
+	}
+	return false
+}
+
+// championMissingStrings reports whether the name, title or blurb of the champion is empty
+func championMissingStrings(champion ChampionData) bool {
+	return champion.Name == "" || champion.Title == "" || champion.Blurb == ""
+}
+
+// fillChampionStrings sets the empty localized strings of the champion to those of the fallback
+func fillChampionStrings(champion *ChampionData, fallback ChampionData) {
+	if champion.Name == "" {
+		champion.Name = fallback.Name
+	}
+	if champion.Title == "" {
+		champion.Title = fallback.Title
+	}
+	if champion.Blurb == "" {
+		champion.Blurb = fallback.Blurb
+	}
+}
+
+// setChampion adds the champion with the given id to the champion caches. The caller must hold the write lock of
+// championsMu.
+func (c *Client) setChampion(id string, champion ChampionDataExtended) {
+	c.championsByID[id] = champion
+	c.championIDsByKey[champion.Key] = id
+}
+
+// GetChampion returns information about the champion with the given name. The name is the id of the champion as used
+// by Data Dragon, which is the english name without spaces and punctuation for most champions, e.g. "Aatrox", "KSante"
+// or "MonkeyKing" for Wukong. The id is matched case-insensitively, e.g. "aatrox" returns Aatrox.
+func (c *Client) GetChampion(name string) (ChampionDataExtended, error) {
+	return c.GetChampionCtx(context.Background(), name)
+}
+
+// GetChampionCtx is like GetChampion but uses the given context for all requests
+func (c *Client) GetChampionCtx(ctx context.Context, name string) (ChampionDataExtended, error) {
+	c.refreshVersionIfExpired(ctx)
+	id, ok := c.cachedChampionID(name)
+	if !ok {
+		// the name is resolved using the list of all champions, as Data Dragon responds with 403 instead of 404 for
+		// champion files which do not exist, e.g. for "aatrox" instead of "Aatrox"
+		var err error
+		if id, err = c.championIDFold(ctx, name); err != nil {
+			return ChampionDataExtended{}, err
+		}
+		if id == "" {
+			return ChampionDataExtended{}, api.ErrNotFound
+		}
+	}
+	return c.getChampion(ctx, id)
+}
+
+// cachedChampionID returns the id of the cached champion which matches the given name case-insensitively and
+// whether a cached champion matches it
+func (c *Client) cachedChampionID(name string) (string, bool) {
+	c.championsMu.RLock()
+	defer c.championsMu.RUnlock()
+	if _, ok := c.championsByID[name]; ok {
+		return name, true
+	}
+	for id := range c.championsByID {
+		if strings.EqualFold(id, name) {
+			return id, true
+		}
+	}
+	return "", false
+}
+
+// championIDFold returns the id of the champion which matches the given name case-insensitively or an empty string if
+// no champion matches it
+func (c *Client) championIDFold(ctx context.Context, name string) (string, error) {
+	var championID string
+	err := c.ensureChampions(ctx, func() {
+		for id := range c.championsByID {
+			if strings.EqualFold(id, name) {
+				championID = id
+				return
+			}
+		}
+	})
+	return championID, err
+}
+
+// getChampion returns the extended information of the champion with the given id, which is retrieved if it is not
+// cached yet
+func (c *Client) getChampion(ctx context.Context, name string) (ChampionDataExtended, error) {
+	c.championsMu.RLock()
+	champion, ok := c.championsByID[name]
+	expired := c.expired(c.championsUpdated)
+	c.championsMu.RUnlock()
+	if ok && champion.Lore != "" && !expired {
+		return champion, nil
+	}
+	// the champion is requested without holding the lock, concurrent lookups of the same champion share one request.
+	// The request is not cancelled together with the lookup which started it, every lookup only waits for it as long
+	// as its own context allows.
+	endpoint := fmt.Sprintf("/champion/%s.json", name)
+	result := c.championGroup.DoChan(c.url(dataDragonDataURLFormat, endpoint), func() (interface{}, error) {
+		return c.fetchChampion(detachedContext{ctx}, name)
+	})
+	select {
+	case <-ctx.Done():
+		return ChampionDataExtended{}, ctx.Err()
+	case res := <-result:
+		if res.Err != nil {
+			return ChampionDataExtended{}, res.Err
+		}
+		return res.Val.(ChampionDataExtended), nil
+	}
+}
+
+// fetchChampion requests the extended information of the champion with the given id and adds it to the cache
+func (c *Client) fetchChampion(ctx context.Context, name string) (ChampionDataExtended, error) {
+	endpoint := fmt.Sprintf("/champion/%s.json", name)
+	var data map[string]ChampionDataExtended
+	if err := c.getInto(ctx, endpoint, &data); err != nil {
+		return ChampionDataExtended{}, err
+	}
+	champion, ok := data[name]
+	if !ok {
+		return ChampionDataExtended{}, api.ErrNotFound
+	}
+	if c.useLanguageFallback() {
+		c.fillChampionExtendedStrings(ctx, name, &champion)
+	}
+	c.championsMu.Lock()
+	defer c.championsMu.Unlock()
+	if c.expired(c.championsUpdated) {
+		c.championsByID = map[string]ChampionDataExtended{}
+		c.championIDsByKey = map[string]string{}
+		atomic.StoreUint32(&c.getChampionsToggle, 0)
+		c.championsUpdated = time.Now()
+	}
+	c.setChampion(name, champion)
+	return champion, nil
+}
+
+// fillChampionExtendedStrings sets the empty localized strings and lore of the champion with the given id to those in
+// the fallback language
+func (c *Client) fillChampionExtendedStrings(ctx context.Context, name string, champion *ChampionDataExtended) {
+	if champion.Lore != "" && !championMissingStrings(champion.ChampionData) {
+		return
+	}
+	var fallback map[string]ChampionDataExtended
+	if err := c.getFallbackInto(ctx, fmt.Sprintf("/champion/%s.json", name), &fallback); err != nil {
+		c.logger.WithField("language", c.languageFallback).Warn(err)
+	}
+	fillChampionStrings(&champion.ChampionData, fallback[name].ChampionData)
+	if champion.Lore == "" {
+		champion.Lore = fallback[name].Lore
+	}
+}
+
+// detachedContext keeps the values of its parent context but is never cancelled
+type detachedContext struct {
+	context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (detachedContext) Err() error {
+	return nil
+}
+
+// DiffChampions compares the champions of the two versions, e.g. "13.23.1" and "13.24.1". It returns the ids of the
+// champions which exist in v2 but not in v1, those which exist in v1 but not in v2 and those whose data differs
+// between the versions, each sorted. The champions are requested in the language of the client without changing its
+// version or caches.
+func (c *Client) DiffChampions(v1, v2 string) (added, removed, changed []string, err error) {
+	return c.DiffChampionsCtx(context.Background(), v1, v2)
+}
+
+// DiffChampionsCtx is like DiffChampions but uses the given context for all requests
+func (c *Client) DiffChampionsCtx(ctx context.Context, v1, v2 string) (added, removed, changed []string, err error) {
+	get := func(version string) (map[string]ChampionData, error) {
+		if !isValidVersion(version) {
+			return nil, fmt.Errorf("invalid version %s", version)
+		}
+		var champions map[string]ChampionData
+		err := c.getVersionedInto(ctx, "/champion.json", version, c.GetLanguage(), &champions)
+		return champions, err
+	}
+	before, err := get(v1)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	after, err := get(v2)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	for id, champion := range after {
+		previous, ok := before[id]
+		if !ok {
+			added = append(added, id)
+			continue
+		}
+		// every champion contains the version it was retrieved for
+		previous.Version, champion.Version = "", ""
+		if !reflect.DeepEqual(previous, champion) {
+			changed = append(changed, id)
+		}
+	}
+	for id := range before {
+		if _, ok := after[id]; !ok {
+			removed = append(removed, id)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	sort.Strings(changed)
+	return added, removed, changed, nil
+}
+
+// ItemsAddedInVersion returns the items which exist in the given version, e.g. "13.24.1", but not in the version before
+// it, sorted by id. The items are requested in the language of the client without changing its version or caches.
+func (c *Client) ItemsAddedInVersion(version string) ([]Item, error) {
+	return c.ItemsAddedInVersionCtx(context.Background(), version)
+}
+
+// ItemsAddedInVersionCtx is like ItemsAddedInVersion but uses the given context for all requests
+func (c *Client) ItemsAddedInVersionCtx(ctx context.Context, version string) ([]Item, error) {
+	if !isValidVersion(version) {
+		return nil, fmt.Errorf("invalid version %s", version)
+	}
+	previous, err := c.previousVersion(ctx, version)
+	if err != nil {
+		return nil, err
+	}
+	var before, after map[string]itemData
+	if err := c.getVersionedInto(ctx, "/item.json", previous, c.GetLanguage(), &before); err != nil {
+		return nil, err
+	}
+	if err := c.getVersionedInto(ctx, "/item.json", version, c.GetLanguage(), &after); err != nil {
+		return nil, err
+	}
+	var added []Item
+	for id, item := range itemsFromData(after) {
+		if _, ok := before[id]; !ok {
+			added = append(added, item)
+		}
+	}
+	sort.Slice(added, func(i, j int) bool {
+		return added[i].ID < added[j].ID
+	})
+	return added, nil
+}
+
+// previousVersion returns the highest version listed by GetVersions which is lower than the given version
+func (c *Client) previousVersion(ctx context.Context, version string) (string, error) {
+	versions, err := c.GetVersionsCtx(ctx)
+	if err != nil {
+		return "", err
+	}
+	var previous string
+	for _, v := range versions {
+		if isValidVersion(v) && CompareVersions(v, version) < 0 &&
+			(previous == "" || CompareVersions(v, previous) > 0) {
+			previous = v
+		}
+	}
+	if previous == "" {
+		return "", fmt.Errorf("no version before %s", version)
+	}
+	return previous, nil
+}
+
+// GetChampionsExtended returns the extended information about the champions with the given names by their name.
+// Champions which are not cached yet are retrieved concurrently. If any champion cannot be retrieved, the first error
+// encountered is returned.
+func (c *Client) GetChampionsExtended(names ...string) (map[string]ChampionDataExtended, error) {
+	return c.GetChampionsExtendedCtx(context.Background(), names...)
+}
+
+// GetChampionsExtendedCtx is like GetChampionsExtended but uses the given context for all requests
+func (c *Client) GetChampionsExtendedCtx(ctx context.Context, names ...string) (
+	map[string]ChampionDataExtended, error) {
+	var mu sync.Mutex
+	res := make(map[string]ChampionDataExtended, len(names))
+	semaphore := make(chan struct{}, c.concurrencyLimit())
+	group, groupCtx := errgroup.WithContext(ctx)
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		name := name
+		goLimited(group, semaphore, func() error {
+			champion, err := c.GetChampionCtx(groupCtx, name)
+			if err != nil {
+				return err
+			}
+			mu.Lock()
+			res[name] = champion
+			mu.Unlock()
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetAllChampionsExtended returns the extended information about all champions by their id, e.g. "MonkeyKing" for
+// Wukong. Champions which are not cached yet are retrieved concurrently.
+func (c *Client) GetAllChampionsExtended() (map[string]ChampionDataExtended, error) {
+	return c.GetAllChampionsExtendedCtx(context.Background())
+}
+
+// GetAllChampionsExtendedCtx is like GetAllChampionsExtended but uses the given context for all requests
+func (c *Client) GetAllChampionsExtendedCtx(ctx context.Context) (map[string]ChampionDataExtended, error) {
+	champions, err := c.GetChampionsCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(champions))
+	for _, champion := range champions {
+		ids = append(ids, champion.ID)
+	}
+	return c.GetChampionsExtendedCtx(ctx, ids...)
+}
+
+// GetChampionLore returns the full lore of the champion with the given name
+func (c *Client) GetChampionLore(name string) (string, error) {
+	return c.GetChampionLoreCtx(context.Background(), name)
+}
+
+// GetChampionLoreCtx is like GetChampionLore but uses the given context for all requests
+func (c *Client) GetChampionLoreCtx(ctx context.Context, name string) (string, error) {
+	champion, err := c.GetChampionCtx(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	return champion.Lore, nil
+}
+
+// GetChampionBlurb returns the short introduction of the champion with the given name. Unlike GetChampionLore it only
+// requires the list of all champions.
+func (c *Client) GetChampionBlurb(name string) (string, error) {
+	return c.GetChampionBlurbCtx(context.Background(), name)
+}
+
+// GetChampionBlurbCtx is like GetChampionBlurb but uses the given context for all requests
+func (c *Client) GetChampionBlurbCtx(ctx context.Context, name string) (string, error) {
+	c.refreshVersionIfExpired(ctx)
+	id, err := c.championIDFold(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	c.championsMu.RLock()
+	defer c.championsMu.RUnlock()
+	champion, ok := c.championsByID[id]
+	if !ok {
+		return "", api.ErrNotFound
+	}
+	return champion.Blurb, nil
+}
+
+// GetChampionSkins returns all skins of the champion with the given name
+func (c *Client) GetChampionSkins(name string) ([]SkinData, error) {
+	return c.GetChampionSkinsCtx(context.Background(), name)
+}
+
+// GetChampionSkinsCtx is like GetChampionSkins but uses the given context for all requests
+func (c *Client) GetChampionSkinsCtx(ctx context.Context, name string) ([]SkinData, error) {
+	champion, err := c.GetChampionCtx(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]SkinData, len(champion.Skins))
+	copy(res, champion.Skins)
+	return res, nil
+}
+
+// GetRecommendedItems returns the item builds recommended by Riot for the champion with the given name. Recent
+// versions of Data Dragon do not contain recommended builds, in which case the result is empty.
+func (c *Client) GetRecommendedItems(name string) ([]RecommendedItemData, error) {
+	return c.GetRecommendedItemsCtx(context.Background(), name)
+}
+
+// GetRecommendedItemsCtx is like GetRecommendedItems but uses the given context for all requests
+func (c *Client) GetRecommendedItemsCtx(ctx context.Context, name string) ([]RecommendedItemData, error) {
+	champion, err := c.GetChampionCtx(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]RecommendedItemData, len(champion.RecommendedItems))
+	copy(res, champion.RecommendedItems)
+	return res, nil
+}
+
+// skinEntry is an entry of the skin index, it contains a skin and the champion it belongs to
+type skinEntry struct {
+	champion ChampionData
+	skin     SkinData
+}
+
+// GetSkin returns the skin with the given id, e.g. "266001", and the champion it belongs to. Finding a skin requires
+// the extended information of all champions the first time it is called, the resulting index is cached afterwards.
+func (c *Client) GetSkin(skinID string) (ChampionData, SkinData, error) {
+	return c.GetSkinCtx(context.Background(), skinID)
+}
+
+// GetSkinCtx is like GetSkin but uses the given context for all requests
+func (c *Client) GetSkinCtx(ctx context.Context, skinID string) (ChampionData, SkinData, error) {
+	c.refreshVersionIfExpired(ctx)
+	c.skinsMu.RLock()
+	skins, updated := c.skinsByID, c.skinsUpdated
+	c.skinsMu.RUnlock()
+	if skins == nil || c.expired(updated) {
+		var err error
+		if skins, err = c.fetchSkins(ctx); err != nil {
+			return ChampionData{}, SkinData{}, err
+		}
+	}
+	entry, ok := skins[skinID]
+	if !ok {
+		return ChampionData{}, SkinData{}, api.ErrNotFound
+	}
+	return entry.champion, entry.skin, nil
+}
+
+// fetchSkins retrieves all champions, builds the skin index and caches it. The champions are retrieved without
+// holding skinsMu, as refreshing the version while retrieving them clears the skin index.
+func (c *Client) fetchSkins(ctx context.Context) (map[string]skinEntry, error) {
+	champions, err := c.GetAllChampionsExtendedCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	skins := map[string]skinEntry{}
+	for _, champion := range champions {
+		for _, skin := range champion.Skins {
+			skins[skin.ID] = skinEntry{champion: champion.ChampionData, skin: skin}
+		}
+	}
+	c.skinsMu.Lock()
+	c.skinsByID, c.skinsUpdated = skins, time.Now()
+	c.skinsMu.Unlock()
+	return skins, nil
+}
+
+// GetChampionTips returns the tips for playing as and against the champion with the given name
+func (c *Client) GetChampionTips(name string) (ally, enemy []string, err error) {
+	return c.GetChampionTipsCtx(context.Background(), name)
+}
+
+// GetChampionTipsCtx is like GetChampionTips but uses the given context for all requests
+func (c *Client) GetChampionTipsCtx(ctx context.Context, name string) (ally, enemy []string, err error) {
+	champion, err := c.GetChampionCtx(ctx, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	ally = make([]string, len(champion.AllyTips))
+	copy(ally, champion.AllyTips)
+	enemy = make([]string, len(champion.EnemyTips))
+	copy(enemy, champion.EnemyTips)
+	return ally, enemy, nil
+}
+
+// GetChampionSpell returns the spell with the given id, e.g. "AatroxQ", of the champion with the given name
+func (c *Client) GetChampionSpell(name, spellID string) (SpellData, error) {
+	return c.GetChampionSpellCtx(context.Background(), name, spellID)
+}
+
+// GetChampionSpellCtx is like GetChampionSpell but uses the given context for all requests
+func (c *Client) GetChampionSpellCtx(ctx context.Context, name, spellID string) (SpellData, error) {
+	champion, err := c.GetChampionCtx(ctx, name)
+	if err != nil {
+		return SpellData{}, err
+	}
+	for _, spell := range champion.Spells {
+		if spell.ID == spellID {
+			return spell, nil
+		}
+	}
+	return SpellData{}, api.ErrNotFound
+}
+
+// ChampionStatsAtLevel returns the stats of the champion with the given name at the given level between 1 and 18
+func (c *Client) ChampionStatsAtLevel(name string, level int) (ChampionStats, error) {
+	return c.ChampionStatsAtLevelCtx(context.Background(), name, level)
+}
+
+// ChampionStatsAtLevelCtx is like ChampionStatsAtLevel but uses the given context for all requests
+func (c *Client) ChampionStatsAtLevelCtx(ctx context.Context, name string, level int) (ChampionStats, error) {
+	if level < 1 || level > maxChampionLevel {
+		return ChampionStats{}, fmt.Errorf("invalid level %d", level)
+	}
+	champion, err := c.GetChampionCtx(ctx, name)
+	if err != nil {
+		return ChampionStats{}, err
+	}
+	return champion.Stats.AtLevel(level), nil
+}
+
+// GetProfileIcons returns all existing profile icons
+func (c *Client) GetProfileIcons() ([]ProfileIcon, error) {
+	return c.GetProfileIconsCtx(context.Background())
+}
+
+// GetProfileIconsCtx is like GetProfileIcons but uses the given context for all requests
+func (c *Client) GetProfileIconsCtx(ctx context.Context) ([]ProfileIcon, error) {
+	c.refreshVersionIfExpired(ctx)
+	unlock, toggle := internal.RWLockToggle(&c.profileIconsMu)
+	defer unlock()
+	if len(c.profileIcons) < 1 || c.expired(c.profileIconsUpdated) {
+		toggle()
+		if len(c.profileIcons) < 1 || c.expired(c.profileIconsUpdated) {
+			if err := c.fetchProfileIcons(ctx); err != nil {
+				return nil, err
+			}
+		}
+	}
+	res := make([]ProfileIcon, len(c.profileIcons))
+	copy(res, c.profileIcons)
+	return res, nil
+}
+
+// GetProfileIcon return information about the profile icon with the given id
+func (c *Client) GetProfileIcon(id int) (ProfileIcon, error) {
+	return c.GetProfileIconCtx(context.Background(), id)
+}
+
+// GetProfileIconCtx is like GetProfileIcon but uses the given context for all requests
+func (c *Client) GetProfileIconCtx(ctx context.Context, id int) (ProfileIcon, error) {
+	c.refreshVersionIfExpired(ctx)
+	unlock, toggle := internal.RWLockToggle(&c.profileIconsMu)
+	defer unlock()
+	if len(c.profileIcons) < 1 || c.expired(c.profileIconsUpdated) {
+		toggle()
+		if len(c.profileIcons) < 1 || c.expired(c.profileIconsUpdated) {
+			if err := c.fetchProfileIcons(ctx); err != nil {
+				return ProfileIcon{}, err
+			}
+		}
+	}
+	icon, ok := c.profileIconsByID[id]
+	if !ok {
+		return ProfileIcon{}, api.ErrNotFound
+	}
+	return icon, nil
+}
+
+// fetchProfileIcons retrieves all profile icons and populates the profile icon caches.
+// The caller must hold the write lock of profileIconsMu.
+func (c *Client) fetchProfileIcons(ctx context.Context) error {
+	body, err := c.get(ctx, dataDragonDataURLFormat, "/profileicon.json")
+	if err != nil {
+		return err
+	}
+	return c.loadProfileIcons(body)
+}
+
+// loadProfileIcons populates the profile icon caches from the content of a profileicon.json data file.
+// The caller must hold the write lock of profileIconsMu.
+func (c *Client) loadProfileIcons(body []byte) error {
+	var res map[string]ProfileIcon
+	if err := decodeData(body, &res); err != nil {
+		return err
+	}
+	icons := make([]ProfileIcon, 0, len(res))
+	for _, icon := range res {
+		icons = append(icons, icon)
+	}
+	c.setProfileIcons(icons)
+	return nil
+}
+
+// setProfileIcons replaces the profile icon caches with the given profile icons. The caller must hold the write lock
+// of profileIconsMu.
+func (c *Client) setProfileIcons(icons []ProfileIcon) {
+	c.profileIcons = icons
+	c.profileIconsByID = make(map[int]ProfileIcon, len(icons))
+	for _, icon := range icons {
+		c.profileIconsByID[icon.ID] = icon
+	}
+	c.profileIconsUpdated = time.Now()
+}
+
+// GetItems returns all existing items
+func (c *Client) GetItems() ([]Item, error) {
+	return c.GetItemsCtx(context.Background())
+}
+
+// GetItemsCtx is like GetItems but uses the given context for all requests
+func (c *Client) GetItemsCtx(ctx context.Context) ([]Item, error) {
+	c.refreshVersionIfExpired(ctx)
+	var res []Item
+	err := c.ensureItems(ctx, func() {
+		res = make([]Item, len(c.items))
+		copy(res, c.items)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetItemCount returns the number of existing items
+func (c *Client) GetItemCount() (int, error) {
+	return c.GetItemCountCtx(context.Background())
+}
+
+// GetItemCountCtx is like GetItemCount but uses the given context for all requests
+func (c *Client) GetItemCountCtx(ctx context.Context) (int, error) {
+	c.refreshVersionIfExpired(ctx)
+	var count int
+	err := c.ensureItems(ctx, func() {
+		count = len(c.items)
+	})
+	return count, err
+}
+
+// GetItem return information about the item with the given id
+func (c *Client) GetItem(id string) (Item, error) {
+	return c.GetItemCtx(context.Background(), id)
+}
+
+// GetItemCtx is like GetItem but uses the given context for all requests
+func (c *Client) GetItemCtx(ctx context.Context, id string) (Item, error) {
+	c.refreshVersionIfExpired(ctx)
+	var item Item
+	var ok bool
+	err := c.ensureItems(ctx, func() {
+		item, ok = c.itemsByID[id]
+	})
+	if err != nil {
+		return Item{}, err
+	}
+	if !ok {
+		return Item{}, fmt.Errorf("no item with id %s: %w", id, api.ErrNotFound)
+	}
+	return item, nil
+}
+
+// ensureItems retrieves all items if they are not cached yet or have expired. read is called afterwards while itemsMu
+// is still locked.
+func (c *Client) ensureItems(ctx context.Context, read func()) error {
+	return c.getCached(&c.itemsMu, &c.itemsUpdated, func() bool {
+		return len(c.items) > 0
+	}, func() error {
+		return c.fetchItems(ctx)
+	}, read)
+}
+
+// itemData is an item as it is contained in the item data file. Items are listed in the store unless stated otherwise.
+type itemData struct {
+	Item
+	InStore *bool `json:"inStore"`
+}
+
+// fetchItems retrieves all items and populates the item caches.
+// The caller must hold the write lock of itemsMu.
+func (c *Client) fetchItems(ctx context.Context) error {
+	body, err := c.get(ctx, dataDragonDataURLFormat, "/item.json")
+	if err != nil {
+		return err
+	}
+	if err := c.loadItems(body); err != nil {
+		return err
+	}
+	if c.useLanguageFallback() {
+		c.fillItemStrings(ctx)
+	}
+	return nil
+}
+
+// fillItemStrings sets the empty names and descriptions of the cached items to those in the fallback language. The
+// caller must hold the write lock of itemsMu.
+func (c *Client) fillItemStrings(ctx context.Context) {
+	missing := false
+	for _, item := range c.items {
+		missing = missing || item.Name == "" || item.Description == ""
+	}
+	if !missing {
+		return
+	}
+	var fallback map[string]Item
+	if err := c.getFallbackInto(ctx, "/item.json", &fallback); err != nil {
+		c.logger.WithField("language", c.languageFallback).Warn(err)
+		return
+	}
+	items := make(map[string]Item, len(c.itemsByID))
+	for id, item := range c.itemsByID {
+		if item.Name == "" {
+			item.Name = fallback[id].Name
+		}
+		if item.Description == "" {
+			item.Description = fallback[id].Description
+		}
+		items[id] = item
+	}
+	c.setItems(items)
+}
+
+// loadItems populates the item caches from the content of an item.json data file. The caller must hold the write
+// lock of itemsMu.
+func (c *Client) loadItems(body []byte) error {
+	var res map[string]itemData
+	if err := decodeData(body, &res); err != nil {
+		return err
+	}
+	c.setItems(itemsFromData(res))
+	return nil
+}
+
+// itemsFromData returns the items of the decoded content of an item.json data file by id
+func itemsFromData(res map[string]itemData) map[string]Item {
+	items := make(map[string]Item, len(res))
+	for id, data := range res {
+		item := data.Item
+		item.ID = id
+		item.InStore = data.InStore == nil || *data.InStore
+		items[id] = item
+	}
+	return items
+}
+
+// setItems replaces the item caches with the given items by id. The caller must hold the write lock of itemsMu.
+func (c *Client) setItems(items map[string]Item) {
+	c.items = make([]Item, 0, len(items))
+	c.itemsByID = make(map[string]Item, len(items))
+	for id, item := range items {
+		c.items = append(c.items, item)
+		c.itemsByID[id] = item
+	}
+	c.itemsUpdated = time.Now()
+}
+
+// GetItemBuildTree returns the build path of the item with the given id. The components of every item are resolved
+// recursively. Components which are unknown are contained only with their id.
+func (c *Client) GetItemBuildTree(id string) (*ItemTree, error) {
+	return c.GetItemBuildTreeCtx(context.Background(), id)
+}
+
+// GetItemBuildTreeCtx is like GetItemBuildTree but uses the given context for all requests
+func (c *Client) GetItemBuildTreeCtx(ctx context.Context, id string) (*ItemTree, error) {
+	c.refreshVersionIfExpired(ctx)
+	var tree *ItemTree
+	var treeErr error
+	err := c.ensureItems(ctx, func() {
+		if _, ok := c.itemsByID[id]; !ok {
+			treeErr = api.ErrNotFound
+			return
+		}
+		tree, treeErr = c.itemBuildTree(id, map[string]bool{})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tree, treeErr
+}
+
+// itemBuildTree resolves the build path of the item with the given id. visited contains the ids of all items on the
+// path to the item and is used to detect cycles. The caller must hold the read lock of itemsMu.
+func (c *Client) itemBuildTree(id string, visited map[string]bool) (*ItemTree, error) {
+	if visited[id] {
+		return nil, fmt.Errorf("cycle in build path of item %s", id)
+	}
+	item, ok := c.itemsByID[id]
+	if !ok {
+		return &ItemTree{Item: Item{ID: id}}, nil
+	}
+	visited[id] = true
+	defer delete(visited, id)
+	tree := &ItemTree{Item: item}
+	for _, componentID := range item.From {
+		component, err := c.itemBuildTree(componentID, visited)
+		if err != nil {
+			return nil, err
+		}
+		tree.Components = append(tree.Components, component)
+	}
+	return tree, nil
+}
+
+// GetMasteries returns all existing masteries. Masteries were removed after patch 7.23.1, for any version higher than
+// that ErrLegacyRunesRemoved is returned unless an older version is set with WithLegacyRuneVersion.
+func (c *Client) GetMasteries() ([]Mastery, error) {
+	return c.GetMasteriesCtx(context.Background())
+}
+
+// GetMasteriesCtx is like GetMasteries but uses the given context for all requests
+func (c *Client) GetMasteriesCtx(ctx context.Context) ([]Mastery, error) {
+	c.refreshVersionIfExpired(ctx)
+	unlock, toggle := internal.RWLockToggle(&c.masteriesMu)
+	defer unlock()
+	if len(c.masteries) < 1 || c.expired(c.masteriesUpdated) {
+		toggle()
+		if len(c.masteries) < 1 || c.expired(c.masteriesUpdated) {
+			if err := c.fetchMasteries(ctx); err != nil {
+				return nil, err
+			}
+		}
+	}
+	res := make([]Mastery, len(c.masteries))
+	copy(res, c.masteries)
+	return res, nil
+}
+
+// GetMasteryTrees returns the trees of the legacy mastery system, e.g. Ferocity, sorted by name. Like GetMasteries
+// it returns ErrLegacyRunesRemoved for versions after patch 7.23.1 unless an older version is set with
+// WithLegacyRuneVersion.
+func (c *Client) GetMasteryTrees() ([]MasteryTree, error) {
+	return c.GetMasteryTreesCtx(context.Background())
+}
+
+// GetMasteryTreesCtx is like GetMasteryTrees but uses the given context for all requests
+func (c *Client) GetMasteryTreesCtx(ctx context.Context) ([]MasteryTree, error) {
+	c.refreshVersionIfExpired(ctx)
+	unlock, toggle := internal.RWLockToggle(&c.masteriesMu)
+	defer unlock()
+	// the trees are nil until they are fetched, as data without any trees would be requested again otherwise
+	if c.masteryTrees == nil || c.expired(c.masteriesUpdated) {
+		toggle()
+		if c.masteryTrees == nil || c.expired(c.masteriesUpdated) {
+			if err := c.fetchMasteries(ctx); err != nil {
+				return nil, err
+			}
+		}
+	}
+	res := make([]MasteryTree, len(c.masteryTrees))
+	copy(res, c.masteryTrees)
+	return res, nil
+}
+
+// fetchMasteries populates the mastery caches. The caller must hold the write lock of masteriesMu.
+func (c *Client) fetchMasteries(ctx context.Context) error {
+	body, err := c.get(ctx, dataDragonDataURLFormat, "/mastery.json")
+	if err != nil {
+		return err
+	}
+	var res struct {
+		Data map[string]Mastery `json:"data"`
+		// Tree contains the tiers of each tree by the name of the tree. Empty slots of a tier are null.
+		Tree map[string][]struct {
+			Items []*struct {
+				ID int `json:"masteryId"`
+			} `json:"masteryTreeItems"`
+		} `json:"tree"`
+	}
+	if err := json.Unmarshal(body, &res); err != nil {
+		return err
+	}
+	c.masteries = make([]Mastery, 0, len(res.Data))
+	masteriesByID := make(map[int]Mastery, len(res.Data))
+	for _, mastery := range res.Data {
+		c.masteries = append(c.masteries, mastery)
+		masteriesByID[mastery.ID] = mastery
+	}
+	c.masteryTrees = make([]MasteryTree, 0, len(res.Tree))
+	for name, tiers := range res.Tree {
+		tree := MasteryTree{Name: name, Tiers: make([][]Mastery, len(tiers))}
+		for i, tier := range tiers {
+			for _, item := range tier.Items {
+				if item == nil {
+					continue
+				}
+				if mastery, ok := masteriesByID[item.ID]; ok {
+					tree.Tiers[i] = append(tree.Tiers[i], mastery)
+				}
+			}
+		}
+		c.masteryTrees = append(c.masteryTrees, tree)
+	}
+	sort.Slice(c.masteryTrees, func(i, j int) bool {
+		return c.masteryTrees[i].Name < c.masteryTrees[j].Name
+	})
+	c.masteriesUpdated = time.Now()
+	return nil
+}
+
+// GetMastery returns information about the mastery with the given id
+func (c *Client) GetMastery(id int) (Mastery, error) {
+	return c.GetMasteryCtx(context.Background(), id)
+}
+
+// GetMasteryCtx is like GetMastery but uses the given context for all requests
+func (c *Client) GetMasteryCtx(ctx context.Context, id int) (Mastery, error) {
+	masteries, err := c.GetMasteriesCtx(ctx)
+	if err != nil {
+		return Mastery{}, err
+	}
+	for _, mastery := range masteries {
+		if mastery.ID == id {
+			return mastery, nil
+		}
+	}
+	return Mastery{}, api.ErrNotFound
+}
+
+// GetRunes returns all existing runes. Runes were removed after patch 7.23.1, for any version higher than that
+// ErrLegacyRunesRemoved is returned unless an older version is set with WithLegacyRuneVersion.
+func (c *Client) GetRunes() ([]Item, error) {
+	return c.GetRunesCtx(context.Background())
+}
+
+// GetRunesCtx is like GetRunes but uses the given context for all requests
+func (c *Client) GetRunesCtx(ctx context.Context) ([]Item, error) {
+	c.refreshVersionIfExpired(ctx)
+	unlock, toggle := internal.RWLockToggle(&c.runesMu)
+	defer unlock()
+	if len(c.runes) < 1 || c.expired(c.runesUpdated) {
+		toggle()
+		if len(c.runes) < 1 || c.expired(c.runesUpdated) {
+			var res map[string]Item
+			if err := c.getInto(ctx, "/rune.json", &res); err != nil {
+				return nil, err
+			}
+			c.runes = make([]Item, 0, len(res))
+			for id, runeItem := range res {
+				runeItem.ID = id
+				c.runes = append(c.runes, runeItem)
+			}
+			c.runesUpdated = time.Now()
+		}
+	}
+	res := make([]Item, len(c.runes))
+	copy(res, c.runes)
+	return res, nil
+}
+
+// GetRune returns information about the rune with the given id
+func (c *Client) GetRune(id string) (Item, error) {
+	return c.GetRuneCtx(context.Background(), id)
+}
+
+// GetRuneCtx is like GetRune but uses the given context for all requests
+func (c *Client) GetRuneCtx(ctx context.Context, id string) (Item, error) {
+	runes, err := c.GetRunesCtx(ctx)
+	if err != nil {
+		return Item{}, err
+	}
+	for _, r := range runes {
+		if r.ID == id {
+			return r, nil
+		}
+	}
+	return Item{}, api.ErrNotFound
+}
+
+// GetReforgedRunes returns all existing rune paths of the Runes Reforged system which replaced runes and masteries in
+// patch 7.22.1
+func (c *Client) GetReforgedRunes() ([]RuneReforgedPath, error) {
+	return c.GetReforgedRunesCtx(context.Background())
+}
+
+// GetReforgedRunesCtx is like GetReforgedRunes but uses the given context for all requests
+func (c *Client) GetReforgedRunesCtx(ctx context.Context) ([]RuneReforgedPath, error) {
+	c.refreshVersionIfExpired(ctx)
+	unlock, toggle := internal.RWLockToggle(&c.reforgedRunesMu)
+	defer unlock()
+	if len(c.reforgedRunes) < 1 || c.expired(c.reforgedRunesUpdated) {
+		toggle()
+		if len(c.reforgedRunes) < 1 || c.expired(c.reforgedRunesUpdated) {
+			if err := c.fetchReforgedRunes(ctx); err != nil {
+				return nil, err
+			}
+		}
+	}
+	res := make([]RuneReforgedPath, len(c.reforgedRunes))
+	copy(res, c.reforgedRunes)
+	return res, nil
+}
+
+// GetReforgedRune returns information about the rune of the Runes Reforged system with the given id, e.g. 8005 for
+// Press the Attack
+func (c *Client) GetReforgedRune(id int) (RuneReforged, error) {
+	return c.GetReforgedRuneCtx(context.Background(), id)
+}
+
+// GetReforgedRuneCtx is like GetReforgedRune but uses the given context for all requests
+func (c *Client) GetReforgedRuneCtx(ctx context.Context, id int) (RuneReforged, error) {
+	c.refreshVersionIfExpired(ctx)
+	unlock, toggle := internal.RWLockToggle(&c.reforgedRunesMu)
+	defer unlock()
+	if len(c.reforgedRunes) < 1 || c.expired(c.reforgedRunesUpdated) {
+		toggle()
+		if len(c.reforgedRunes) < 1 || c.expired(c.reforgedRunesUpdated) {
+			if err := c.fetchReforgedRunes(ctx); err != nil {
+				return RuneReforged{}, err
+			}
+		}
+	}
+	r, ok := c.reforgedRunesByID[id]
+	if !ok {
+		return RuneReforged{}, api.ErrNotFound
+	}
+	return r, nil
+}
+
+// fetchReforgedRunes retrieves all rune paths and populates the Runes Reforged caches.
+// The caller must hold the write lock of reforgedRunesMu.
+func (c *Client) fetchReforgedRunes(ctx context.Context) error {
+	body, err := c.get(ctx, dataDragonDataURLFormat, "/runesReforged.json")
+	if err != nil {
+		return err
+	}
+	return c.loadReforgedRunes(body)
+}
+
+// loadReforgedRunes populates the Runes Reforged caches from the content of a runesReforged.json data file.
+// The caller must hold the write lock of reforgedRunesMu.
+func (c *Client) loadReforgedRunes(body []byte) error {
+	var res []RuneReforgedPath
+	if err := json.Unmarshal(body, &res); err != nil {
+		return err
+	}
+	c.setReforgedRunes(res)
+	return nil
+}
+
+// setReforgedRunes replaces the Runes Reforged caches with the given paths. The caller must hold the write lock of
+// reforgedRunesMu.
+func (c *Client) setReforgedRunes(res []RuneReforgedPath) {
+	c.reforgedRunes = res
+	c.reforgedRunesByID = map[int]RuneReforged{}
+	for _, path := range res {
+		for _, slot := range path.Slots {
+			for _, r := range slot.Runes {
+				c.reforgedRunesByID[r.ID] = r
+			}
+		}
+	}
+	c.reforgedRunesUpdated = time.Now()
+}
+
+// GetSummonerSpells returns all existing summoner spells
+func (c *Client) GetSummonerSpells() ([]SummonerSpell, error) {
+	return c.GetSummonerSpellsCtx(context.Background())
+}
+
+// GetSummonerSpellsCtx is like GetSummonerSpells but uses the given context for all requests
+func (c *Client) GetSummonerSpellsCtx(ctx context.Context) ([]SummonerSpell, error) {
+	c.refreshVersionIfExpired(ctx)
+	var res []SummonerSpell
+	err := c.ensureSummonerSpells(ctx, func() {
+		res = make([]SummonerSpell, len(c.summoners))
+		copy(res, c.summoners)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetSummonerSpellCount returns the number of existing summoner spells
+func (c *Client) GetSummonerSpellCount() (int, error) {
+	return c.GetSummonerSpellCountCtx(context.Background())
+}
+
+// GetSummonerSpellCountCtx is like GetSummonerSpellCount but uses the given context for all requests
+func (c *Client) GetSummonerSpellCountCtx(ctx context.Context) (int, error) {
+	c.refreshVersionIfExpired(ctx)
+	var count int
+	err := c.ensureSummonerSpells(ctx, func() {
+		count = len(c.summoners)
+	})
+	return count, err
+}
+
+// GetSummonerSpell returns information about the summoner spell with the given id
+func (c *Client) GetSummonerSpell(id string) (SummonerSpell, error) {
+	return c.GetSummonerSpellCtx(context.Background(), id)
+}
+
+// GetSummonerSpellCtx is like GetSummonerSpell but uses the given context for all requests
+func (c *Client) GetSummonerSpellCtx(ctx context.Context, id string) (SummonerSpell, error) {
+	return c.GetSummonerSpellByIDCtx(ctx, id)
+}
+
+// GetSummonerSpellByID returns information about the summoner spell with the given id, e.g. "SummonerFlash"
+func (c *Client) GetSummonerSpellByID(id string) (SummonerSpell, error) {
+	return c.GetSummonerSpellByIDCtx(context.Background(), id)
+}
+
+// GetSummonerSpellByIDCtx is like GetSummonerSpellByID but uses the given context for all requests
+func (c *Client) GetSummonerSpellByIDCtx(ctx context.Context, id string) (SummonerSpell, error) {
+	c.refreshVersionIfExpired(ctx)
+	var summonerSpell SummonerSpell
+	var ok bool
+	err := c.ensureSummonerSpells(ctx, func() {
+		summonerSpell, ok = c.summonersByID[id]
+	})
+	if err != nil {
+		return SummonerSpell{}, err
+	}
+	if !ok {
+		return SummonerSpell{}, fmt.Errorf("no summoner spell with id %s: %w", id, api.ErrNotFound)
+	}
+	return summonerSpell, nil
+}
+
+// GetSummonerSpellByKey returns information about the summoner spell with the given numeric key, e.g. "4" for
+// Flash, as it is used by the Riot API
+func (c *Client) GetSummonerSpellByKey(key string) (SummonerSpell, error) {
+	return c.GetSummonerSpellByKeyCtx(context.Background(), key)
+}
+
+// GetSummonerSpellByKeyCtx is like GetSummonerSpellByKey but uses the given context for all requests
+func (c *Client) GetSummonerSpellByKeyCtx(ctx context.Context, key string) (SummonerSpell, error) {
+	c.refreshVersionIfExpired(ctx)
+	var summonerSpell SummonerSpell
+	var ok bool
+	err := c.ensureSummonerSpells(ctx, func() {
+		summonerSpell, ok = c.summonersByKey[key]
+	})
+	if err != nil {
+		return SummonerSpell{}, err
+	}
+	if !ok {
+		return SummonerSpell{}, fmt.Errorf("no summoner spell with key %s: %w", key, api.ErrNotFound)
+	}
+	return summonerSpell, nil
+}
+
+// ensureSummonerSpells retrieves all summoner spells if they are not cached yet or have expired. read is called
+// afterwards while summonersMu is still locked.
+func (c *Client) ensureSummonerSpells(ctx context.Context, read func()) error {
+	return c.getCached(&c.summonersMu, &c.summonersUpdated, func() bool {
+		return len(c.summoners) > 0
+	}, func() error {
+		return c.fetchSummonerSpells(ctx)
+	}, read)
+}
+
+// fetchSummonerSpells retrieves all summoner spells and populates the summoner spell caches.
+// The caller must hold the write lock of summonersMu.
+func (c *Client) fetchSummonerSpells(ctx context.Context) error {
+	body, err := c.get(ctx, dataDragonDataURLFormat, "/summoner.json")
+	if err != nil {
+		return err
+	}
+	return c.loadSummonerSpells(body)
+}
+
+// loadSummonerSpells populates the summoner spell caches from the content of a summoner.json data file.
+// The caller must hold the write lock of summonersMu.
+func (c *Client) loadSummonerSpells(body []byte) error {
+	var res map[string]SummonerSpell
+	if err := decodeData(body, &res); err != nil {
+		return err
+	}
+	c.setSummonerSpells(res)
+	return nil
+}
+
+// setSummonerSpells replaces the summoner spell caches with the given summoner spells. The caller must hold the write
+// lock of summonersMu.
+func (c *Client) setSummonerSpells(res map[string]SummonerSpell) {
+	c.summoners = make([]SummonerSpell, 0, len(res))
+	c.summonersByID = make(map[string]SummonerSpell, len(res))
+	c.summonersByKey = make(map[string]SummonerSpell, len(res))
+	for _, summoner := range res {
+		c.summoners = append(c.summoners, summoner)
+		c.summonersByID[summoner.ID] = summoner
+		c.summonersByKey[summoner.Key] = summoner
+	}
+	c.summonersUpdated = time.Now()
+}
+
+// GetMaps returns all existing game maps
+func (c *Client) GetMaps() ([]GameMap, error) {
+	return c.GetMapsCtx(context.Background())
+}
+
+// GetMapsCtx is like GetMaps but uses the given context for all requests
+func (c *Client) GetMapsCtx(ctx context.Context) ([]GameMap, error) {
+	c.refreshVersionIfExpired(ctx)
+	unlock, toggle := internal.RWLockToggle(&c.mapsMu)
+	defer unlock()
+	if len(c.maps) < 1 || c.expired(c.mapsUpdated) {
+		toggle()
+		if len(c.maps) < 1 || c.expired(c.mapsUpdated) {
+			if err := c.fetchMaps(ctx); err != nil {
+				return nil, err
+			}
+		}
+	}
+	res := make([]GameMap, len(c.maps))
+	copy(res, c.maps)
+	return res, nil
+}
+
+// GetMap returns information about the game map with the given id, e.g. 11 for Summoner's Rift, as it is used by the
+// Riot API
+func (c *Client) GetMap(id int) (GameMap, error) {
+	return c.GetMapCtx(context.Background(), id)
+}
+
+// GetMapCtx is like GetMap but uses the given context for all requests
+func (c *Client) GetMapCtx(ctx context.Context, id int) (GameMap, error) {
+	c.refreshVersionIfExpired(ctx)
+	unlock, toggle := internal.RWLockToggle(&c.mapsMu)
+	defer unlock()
+	if len(c.maps) < 1 || c.expired(c.mapsUpdated) {
+		toggle()
+		if len(c.maps) < 1 || c.expired(c.mapsUpdated) {
+			if err := c.fetchMaps(ctx); err != nil {
+				return GameMap{}, err
+			}
+		}
+	}
+	gameMap, ok := c.mapsByID[id]
+	if !ok {
+		return GameMap{}, api.ErrNotFound
+	}
+	return gameMap, nil
+}
+
+// fetchMaps retrieves all game maps and populates the map caches. The caller must hold the write lock of mapsMu.
+func (c *Client) fetchMaps(ctx context.Context) error {
+	body, err := c.get(ctx, dataDragonDataURLFormat, "/map.json")
+	if err != nil {
+		return err
+	}
+	return c.loadMaps(body)
+}
+
+// loadMaps populates the map caches from the content of a map.json data file. The caller must hold the write lock of
+// mapsMu.
+func (c *Client) loadMaps(body []byte) error {
+	var res map[string]GameMap
+	if err := decodeData(body, &res); err != nil {
+		return err
+	}
+	c.maps = make([]GameMap, 0, len(res))
+	c.mapsByID = make(map[int]GameMap, len(res))
+	for _, gameMap := range res {
+		c.maps = append(c.maps, gameMap)
+		c.mapsByID[gameMap.ID] = gameMap
+	}
+	c.mapsUpdated = time.Now()
+	return nil
+}
+
+// GetChallenges returns all existing challenges
+func (c *Client) GetChallenges() ([]Challenge, error) {
+	return c.GetChallengesCtx(context.Background())
+}
+
+// GetChallengesCtx is like GetChallenges but uses the given context for all requests
+func (c *Client) GetChallengesCtx(ctx context.Context) ([]Challenge, error) {
+	c.refreshVersionIfExpired(ctx)
+	unlock, toggle := internal.RWLockToggle(&c.challengesMu)
+	defer unlock()
+	if len(c.challenges) < 1 || c.expired(c.challengesUpdated) {
+		toggle()
+		if len(c.challenges) < 1 || c.expired(c.challengesUpdated) {
+			var res []Challenge
+			if err := c.getRawInto(ctx, "/challenges.json", &res); err != nil {
+				return nil, err
+			}
+			c.challenges = res
+			c.challengesUpdated = time.Now()
+		}
+	}
+	res := make([]Challenge, len(c.challenges))
+	copy(res, c.challenges)
+	return res, nil
+}
+
+// Preload concurrently retrieves all champions, items, summoner spells, profile icons and rune paths, so further calls
+// to the respective getters are served from the caches. The first error encountered is returned.
+func (c *Client) Preload(ctx context.Context) error {
+	semaphore := make(chan struct{}, c.concurrencyLimit())
+	group, ctx := errgroup.WithContext(ctx)
+	goLimited(group, semaphore, func() error {
+		_, err := c.GetChampionsCtx(ctx)
+		return err
+	})
+	goLimited(group, semaphore, func() error {
+		_, err := c.GetItemsCtx(ctx)
+		return err
+	})
+	goLimited(group, semaphore, func() error {
+		_, err := c.GetSummonerSpellsCtx(ctx)
+		return err
+	})
+	goLimited(group, semaphore, func() error {
+		_, err := c.GetProfileIconsCtx(ctx)
+		return err
+	})
+	goLimited(group, semaphore, func() error {
+		_, err := c.GetReforgedRunesCtx(ctx)
+		return err
+	})
+	return group.Wait()
+}
+
+// concurrencyLimit returns the number of requests which are sent concurrently as set with WithMaxConcurrency
+func (c *Client) concurrencyLimit() int {
+	if c.maxConcurrency < 1 {
+		return maxConcurrentRequests
+	}
+	return c.maxConcurrency
+}
+
+// goLimited runs fn in a new goroutine of the group while holding one of the slots of the semaphore
+func goLimited(group *errgroup.Group, semaphore chan struct{}, fn func() error) {
+	group.Go(func() error {
+		semaphore <- struct{}{}
+		defer func() { <-semaphore }()
+		return fn()
+	})
+}
+
+// ClearCaches resets all caches of the data dragon client
+func (c *Client) ClearCaches() {
+	c.responsesMu.Lock()
+	c.responsesByURL = map[string]cachedResponse{}
+	c.responsesMu.Unlock()
+	c.ClearVersionCache()
+	c.ClearLanguageCache()
+	c.ClearChampionCache()
+	c.ClearMasteryCache()
+	c.ClearProfileIconCache()
+	c.ClearItemCache()
+	c.ClearSummonerSpellCache()
+	c.ClearRuneCache()
+	c.ClearReforgedRuneCache()
+	c.ClearMapCache()
+	c.ClearChallengeCache()
+	c.ClearTFTChampionCache()
+	c.ClearTFTItemCache()
+	c.ClearTFTTraitCache()
+	c.ClearTFTAugmentCache()
+	c.ClearTFTQueueCache()
+	c.ClearTFTRegaliaCache()
+	c.ClearTFTArenaCache()
+}
+
+// ClearVersionCache resets the cache of available versions
+func (c *Client) ClearVersionCache() {
+	c.versionsMu.Lock()
+	c.versions = []string{}
+	c.versionsMu.Unlock()
+}
+
+// ClearLanguageCache resets the cache of available languages
+func (c *Client) ClearLanguageCache() {
+	c.languagesMu.Lock()
+	c.languages = []languageCode{}
+	c.languagesMu.Unlock()
+}
+
+// ClearChampionCache resets the cache of champions and of the skins looked up from them
+func (c *Client) ClearChampionCache() {
+	c.championsMu.Lock()
+	c.championsByID = map[string]ChampionDataExtended{}
+	c.championIDsByKey = map[string]string{}
+	atomic.StoreUint32(&c.getChampionsToggle, 0)
+	c.championsMu.Unlock()
+	c.skinsMu.Lock()
+	c.skinsByID = nil
+	c.skinsMu.Unlock()
+}
+
+// ClearMasteryCache resets the cache of masteries
+func (c *Client) ClearMasteryCache() {
+	c.masteriesMu.Lock()
+	c.masteries = []Mastery{}
+	c.masteryTrees = nil
+	c.masteriesMu.Unlock()
+}
+
+// ClearProfileIconCache resets the cache of profile icons
+func (c *Client) ClearProfileIconCache() {
+	c.profileIconsMu.Lock()
+	c.profileIcons = []ProfileIcon{}
+	c.profileIconsByID = map[int]ProfileIcon{}
+	c.profileIconsMu.Unlock()
+}
+
+// ClearItemCache resets the cache of items
+func (c *Client) ClearItemCache() {
+	c.itemsMu.Lock()
+	c.items = []Item{}
+	c.itemsByID = map[string]Item{}
+	c.itemsMu.Unlock()
+}
+
+// ClearSummonerSpellCache resets the cache of summoner spells
+func (c *Client) ClearSummonerSpellCache() {
+	c.summonersMu.Lock()
+	c.summoners = []SummonerSpell{}
+	c.summonersByID = map[string]SummonerSpell{}
+	c.summonersByKey = map[string]SummonerSpell{}
+	c.summonersMu.Unlock()
+}
+
+// ClearRuneCache resets the cache of runes
+func (c *Client) ClearRuneCache() {
+	c.runesMu.Lock()
+	c.runes = []Item{}
+	c.runesMu.Unlock()
+}
+
+// ClearReforgedRuneCache resets the cache of reforged runes
+func (c *Client) ClearReforgedRuneCache() {
+	c.reforgedRunesMu.Lock()
+	c.reforgedRunes = []RuneReforgedPath{}
+	c.reforgedRunesByID = map[int]RuneReforged{}
+	c.reforgedRunesMu.Unlock()
+}
+
+// ClearMapCache resets the cache of maps
+func (c *Client) ClearMapCache() {
+	c.mapsMu.Lock()
+	c.maps = []GameMap{}
+	c.mapsByID = map[int]GameMap{}
+	c.mapsMu.Unlock()
+}
+
+// ClearChallengeCache resets the cache of challenges
+func (c *Client) ClearChallengeCache() {
+	c.challengesMu.Lock()
+	c.challenges = []Challenge{}
+	c.challengesMu.Unlock()
+}
+
+func (c *Client) getInto(ctx context.Context, endpoint string, target interface{}) error {
+	body, err := c.get(ctx, dataDragonDataURLFormat, endpoint)
+	if err != nil {
+		return err
+	}
+	return decodeData(body, target)
+}
+
+// decodeData decodes the data object of the content of a Data Dragon data file into target
+func decodeData(body []byte, target interface{}) error {
+	var ddResponse struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(body, &ddResponse); err != nil {
+		return err
+	}
+	if ddResponse.Data == nil {
+		return nil
+	}
+	return json.Unmarshal(ddResponse.Data, target)
+}
+
+// getRawInto decodes the response of a data file which is not wrapped in the usual Data Dragon response object
+func (c *Client) getRawInto(ctx context.Context, endpoint string, target interface{}) error {
+	body, err := c.get(ctx, dataDragonDataURLFormat, endpoint)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, target)
+}
+
+// get returns the body of the response for the given endpoint. Concurrent requests of the same URL share a single
+// request, which uses the context of the first caller.
+func (c *Client) get(ctx context.Context, format dataDragonURL, endpoint string) ([]byte, error) {
+	request, err := c.newRequest(ctx, format, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	return c.send(request, endpoint, true)
+}
+
+// getFallbackInto decodes the data object of the data file at the endpoint in the fallback language set with
+// WithLanguageFallback into target
+func (c *Client) getFallbackInto(ctx context.Context, endpoint string, target interface{}) error {
+	return c.getVersionedInto(ctx, endpoint, c.GetVersion(), c.languageFallback, target)
+}
+
+// getVersionedInto decodes the data object of the data file at the endpoint in the given version and language into
+// target without changing the version and language of the client
+func (c *Client) getVersionedInto(ctx context.Context, endpoint, version string, language languageCode,
+	target interface{}) error {
+	request, err := c.newVersionedRequest(ctx, dataDragonDataURLFormat, endpoint, version, language)
+	if err != nil {
+		return err
+	}
+	// responses of other versions are only needed once, e.g. by DiffChampions, so their bodies are not kept
+	body, err := c.send(request, endpoint, version == c.GetVersion())
+	if err != nil {
+		return err
+	}
+	return decodeData(body, target)
+}
+
+// useLanguageFallback reports whether missing localized strings are retrieved in the fallback language
+func (c *Client) useLanguageFallback() bool {
+	return c.languageFallback != "" && c.languageFallback != c.GetLanguage()
+}
+
+// send returns the body of the response for the request as described for get. If cache is true the body is kept
+// together with the ETag of the response to answer later requests of the same URL conditionally.
+func (c *Client) send(request *http.Request, endpoint string, cache bool) ([]byte, error) {
+	res, err, _ := c.requestGroup.Do(request.URL.String(), func() (interface{}, error) {
+		return c.sendConditional(request, endpoint, cache)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.([]byte), nil
+}
+
+// sendConditional sends the request with the ETag of the response cached for its URL, if any, and returns the cached
+// body if the server reports it as not modified
+func (c *Client) sendConditional(request *http.Request, endpoint string, cache bool) ([]byte, error) {
+	url := request.URL.String()
+	c.responsesMu.Lock()
+	cached, ok := c.responsesByURL[url]
+	c.responsesMu.Unlock()
+	if ok {
+		request.Header.Set("If-None-Match", cached.etag)
+	}
+	response, err := c.do(request, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if response.Body != nil {
+		defer response.Body.Close()
+	}
+	if response.StatusCode == http.StatusNotModified {
+		if !ok {
+			return nil, api.Error{Message: "not modified without a cached response", StatusCode: response.StatusCode}
+		}
+		return cached.body, nil
+	}
+	if response.Body == nil {
+		return nil, fmt.Errorf("no response body")
+	}
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	if cache {
+		c.cacheResponse(url, response.Header.Get("ETag"), body)
+	}
+	return body, nil
+}
+
+// cacheResponse keeps the body of the response for the given URL if it has an ETag
+func (c *Client) cacheResponse(url, etag string, body []byte) {
+	if etag == "" {
+		return
+	}
+	c.responsesMu.Lock()
+	c.responsesByURL[url] = cachedResponse{etag: etag, body: body}
+	c.responsesMu.Unlock()
+}
+
+// cachedResponse is the body of a response together with its ETag, it is used to answer conditional requests for
+// unchanged files
+type cachedResponse struct {
+	etag string
+	body []byte
+}
+
+func (c *Client) doRequest(ctx context.Context, format dataDragonURL, endpoint string) (*http.Response, error) {
+	request, err := c.newRequest(ctx, format, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	return c.do(request, endpoint)
+}
+
+// do sends the request and retries it as configured by WithRetry if it fails with a retryable error
+func (c *Client) do(request *http.Request, endpoint string) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		response, err := c.doOnce(request, endpoint)
+		if err == nil || attempt >= c.retryAttempts || !isRetryable(request.Context(), err) {
+			return response, err
+		}
+		wait := c.retryBackoff << uint(attempt-1)
+		if rateLimitErr, ok := err.(RateLimitError); ok {
+			// waiting longer than the backoff before the last attempt is left to the caller
+			if rateLimitErr.RetryAfter > c.retryBackoff<<uint(c.retryAttempts-1) {
+				return nil, err
+			}
+			wait = rateLimitErr.RetryAfter
+		}
+		select {
+		case <-request.Context().Done():
+			return nil, request.Context().Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+func (c *Client) doOnce(request *http.Request, endpoint string) (*http.Response, error) {
+	start := time.Now()
+	response, err := c.client.Do(request)
+	if err != nil {
+		c.observeRequest(endpoint, start, 0, err)
+		return nil, err
+	}
+	if (response.StatusCode < 200 || response.StatusCode > 299) && response.StatusCode != http.StatusNotModified {
+		var err error
+		err, ok := api.StatusToError[response.StatusCode]
+		if !ok {
+			err = api.Error{
+				Message:    "unknown error reason",
+				StatusCode: response.StatusCode,
+			}
+		}
+		if response.StatusCode == http.StatusTooManyRequests {
+			if retryAfter, ok := parseRetryAfter(response.Header.Get("Retry-After")); ok {
+				err = RateLimitError{RetryAfter: retryAfter}
+			}
+		}
+		if response.Body != nil {
+			response.Body.Close()
+		}
+		c.observeRequest(endpoint, start, response.StatusCode, err)
+		return nil, err
+	}
+	c.observeRequest(endpoint, start, response.StatusCode, nil)
+	if err := decompressBody(response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// decompressBody replaces the body of a gzip encoded response with a reader returning the decompressed content.
+// Responses are only decompressed by the http package if the Accept-Encoding header was not set explicitly.
+func decompressBody(response *http.Response) error {
+	if response.Body == nil || !strings.EqualFold(response.Header.Get("Content-Encoding"), "gzip") {
+		return nil
+	}
+	reader, err := gzip.NewReader(response.Body)
+	if err != nil {
+		response.Body.Close()
+		return err
+	}
+	response.Body = gzipReadCloser{Reader: reader, body: response.Body}
+	response.Header.Del("Content-Encoding")
+	response.Header.Del("Content-Length")
+	response.ContentLength = -1
+	response.Uncompressed = true
+	return nil
+}
+
+// gzipReadCloser reads from a gzip reader and closes both the gzip reader and the underlying body
+type gzipReadCloser struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+func (r gzipReadCloser) Close() error {
+	if err := r.Reader.Close(); err != nil {
+		r.body.Close()
+		return err
+	}
+	return r.body.Close()
+}
+
+// isRetryable reports whether a request which failed with the given error may succeed if it is sent again. This is the
+// case for network errors, including the *url.Error returned by an http.Client, rate limiting and server errors.
+func isRetryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	switch err := err.(type) {
+	case RateLimitError:
+		return true
+	case api.Error:
+		return err.StatusCode == http.StatusTooManyRequests || err.StatusCode >= 500
+	case net.Error:
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter returns the duration specified by the value of a Retry-After header, which is either a number of
+// seconds or a date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	if retryAfter := time.Until(date); retryAfter > 0 {
+		return retryAfter, true
+	}
+	return 0, true
+}
+
+func (c *Client) observeRequest(endpoint string, start time.Time, statusCode int, err error) {
+	if c.metricsHook != nil {
+		c.metricsHook.ObserveRequest(endpoint, time.Since(start), statusCode, err)
+	}
+}
+
+func (c *Client) newRequest(ctx context.Context, format dataDragonURL, endpoint string) (*http.Request, error) {
+	c.versionMu.RLock()
+	version, language := c.version, c.language
+	c.versionMu.RUnlock()
+	return c.newVersionedRequest(ctx, format, endpoint, version, language)
+}
+
+// newVersionedRequest is like newRequest but requests the endpoint in the given version and language
+func (c *Client) newVersionedRequest(ctx context.Context, format dataDragonURL, endpoint, version string,
+	language languageCode) (*http.Request, error) {
+	if err := c.checkLegacyRuneVersion(endpoint, version); err != nil {
+		return nil, err
+	}
+	request, err := http.NewRequest("GET", c.versionedURL(format, endpoint, version, language), nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Accept-Encoding", "gzip")
+	c.setHeaders(request)
+	return request.WithContext(ctx), nil
+}
+
+// checkLegacyRuneVersion returns ErrLegacyRunesRemoved if the endpoint belongs to the legacy rune and mastery systems
+// and the given version requested for it is after the systems were removed
+func (c *Client) checkLegacyRuneVersion(endpoint, version string) error {
+	if !isLegacyRuneOrMasteryEndpoint(endpoint) || c.legacyRuneVersion != "" {
+		return nil
+	}
+	greater, err := versionGreaterThan(version, latestRuneAndMasteryVersion)
+	if err != nil {
+		c.logger.WithField("version", version).Warn(err)
+		return nil
+	}
+	if greater {
+		return ErrLegacyRunesRemoved
+	}
+	return nil
+}
+
+// setHeaders adds the headers set with WithHeaders to the request
+func (c *Client) setHeaders(request *http.Request) {
+	for key, value := range c.headers {
+		request.Header.Set(key, value)
+	}
+}
+
+// URL returns the URL which is requested for the given endpoint, e.g. "/champion.json" with URLFormatData. The current
+// version and language as well as the version used for legacy rune and mastery endpoints are filled in as for all
+// requests of the client.
+func (c *Client) URL(format dataDragonURL, endpoint string) (string, error) {
+	request, err := c.newRequest(context.Background(), format, endpoint)
+	if err != nil {
+		return "", err
+	}
+	return request.URL.String(), nil
+}
+
+// GetRaw decodes the data file with the given endpoint, e.g. "/tft-tactician.json", into target. It allows decoding
+// files which are not supported by the client otherwise. The data object of files using the usual Data Dragon
+// response object is decoded, other files are decoded as a whole. The result is not cached.
+func (c *Client) GetRaw(endpoint string, target interface{}) error {
+	return c.GetRawCtx(context.Background(), endpoint, target)
+}
+
+// GetRawCtx is like GetRaw but uses the given context for all requests
+func (c *Client) GetRawCtx(ctx context.Context, endpoint string, target interface{}) error {
+	body, err := c.get(ctx, dataDragonDataURLFormat, endpoint)
+	if err != nil {
+		return err
+	}
+	var ddResponse struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(body, &ddResponse); err == nil && ddResponse.Data != nil {
+		return json.Unmarshal(ddResponse.Data, target)
+	}
+	return json.Unmarshal(body, target)
+}
+
+func (c *Client) url(format dataDragonURL, endpoint string) string {
+	c.versionMu.RLock()
+	version, language := c.version, c.language
+	c.versionMu.RUnlock()
+	return c.versionedURL(format, endpoint, version, language)
+}
+
+// versionedURL is like url but uses the given version and language instead of those of the client
+func (c *Client) versionedURL(format dataDragonURL, endpoint, version string, language languageCode) string {
+	if isLegacyRuneOrMasteryEndpoint(endpoint) && c.legacyRuneVersion != "" {
+		version = c.legacyRuneVersion
+	}
+	var url string
+	switch format {
+	case dataDragonDataURLFormat:
+		url = fmt.Sprintf(string(format), version, language)
+	case dataDragonImageURLFormat:
+		url = fmt.Sprintf(string(format), version)
+	default:
+		url = string(format)
+	}
+	if c.baseURL != "" {
+		return c.baseURL + strings.TrimPrefix(url, string(dataDragonBaseURL)) + endpoint
+	}
+	return "https://" + url + endpoint
+}
+
+// isLegacyRuneOrMasteryEndpoint reports whether the endpoint belongs to the rune or mastery system which was removed
+// in patch 7.23.1. Runes Reforged endpoints are not included.
+func isLegacyRuneOrMasteryEndpoint(endpoint string) bool {
+	return strings.HasPrefix(endpoint, "/rune.") || strings.HasPrefix(endpoint, "/rune/") ||
+		strings.HasPrefix(endpoint, "/mastery.") || strings.HasPrefix(endpoint, "/mastery/")
+}
+
+// isValidLanguageCode reports whether the code is one of LanguageCodes
+func isValidLanguageCode(code languageCode) bool {
+	for _, c := range LanguageCodes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// isValidVersion reports whether the version consists of exactly three numeric components, e.g. "9.10.1"
+func isValidVersion(version string) bool {
+	split := strings.Split(version, ".")
+	if len(split) != 3 {
+		return false
+	}
+	for _, component := range split {
+		if _, err := strconv.ParseUint(component, 10, 32); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// CompareVersions returns -1 if v1 is lower than v2, 0 if they are equal and 1 if v1 is greater than v2. Versions are
+// compared component by component, missing components are treated as 0, e.g. "9.10" equals "9.10.0" and is lower than
+// "9.10.1". Numeric components are compared numerically, other components such as in "lolpatch_7.20" are compared
+// lexically.
+func CompareVersions(v1, v2 string) int {
+	cmp, _ := compareVersions(v1, v2)
+	return cmp
+}
+
+// compareVersions is like CompareVersions but also returns an error if any component up to the first differing one is
+// not numeric
+func compareVersions(v1, v2 string) (int, error) {
+	v1Split := strings.Split(v1, ".")
+	v2Split := strings.Split(v2, ".")
+	for len(v1Split) < len(v2Split) {
+		v1Split = append(v1Split, "0")
+	}
+	for len(v2Split) < len(v1Split) {
+		v2Split = append(v2Split, "0")
+	}
+	var firstErr error
+	for i := range v1Split {
+		cmp, err := compareVersionComponents(v1Split[i], v2Split[i])
+		if firstErr == nil {
+			firstErr = err
+		}
+		if cmp != 0 {
+			return cmp, firstErr
+		}
+	}
+	return 0, firstErr
+}
+
+// compareVersionComponents compares two components of versions numerically. If either of them is not numeric they are
+// compared lexically and an error is returned as well.
+func compareVersionComponents(c1, c2 string) (int, error) {
+	int1, err1 := versionComponent(c1)
+	int2, err2 := versionComponent(c2)
+	switch {
+	case err1 != nil:
+		return strings.Compare(c1, c2), err1
+	case err2 != nil:
+		return strings.Compare(c1, c2), err2
+	case int1 < int2:
+		return -1, nil
+	case int1 > int2:
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// IsVersionAtLeast reports whether the current version of the client is equal to or greater than the given version,
+// e.g. to check whether Runes Reforged are available using "7.22"
+func (c *Client) IsVersionAtLeast(version string) bool {
+	c.versionMu.RLock()
+	defer c.versionMu.RUnlock()
+	return CompareVersions(c.version, version) >= 0
+}
+
+// versionGreaterThan reports whether v1 is greater than v2. Versions are compared numerically component by
+// component, missing components are treated as 0. An error is returned if any component is not numeric.
+func versionGreaterThan(v1, v2 string) (bool, error) {
+	cmp, err := compareVersions(v1, v2)
+	if err != nil {
+		return false, err
+	}
+	return cmp > 0, nil
+}
+
+func versionComponent(component string) (int, error) {
+	value, err := strconv.Atoi(component)
+	if err != nil {
+		return 0, fmt.Errorf("invalid version component %q", component)
+	}
+	return value, nil
 }
 
 type dataDragonResponse struct {
@@ -1,11 +1,26 @@
 package datadragon
 
 import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"net/url"
+	"path"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
+	"unsafe"
 
 	log "github.com/sirupsen/logrus"
+	logtest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -20,69 +35,323 @@ func TestNewClient(t *testing.T) {
 	require.NotNil(t, ddClient)
 }
 
-func TestClient_GetChampions(t *testing.T) {
+func TestNewClient_unknownRegion(t *testing.T) {
+	t.Parallel()
+	var requested []string
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			requested = append(requested, r.URL.String())
+			return &http.Response{StatusCode: http.StatusNotFound}, nil
+		},
+	}
+	c := NewClient(doer, api.Region("xx"), log.StandardLogger())
+	assert.Equal(t, "na", c.realmRegion)
+	assert.Equal(t, []string{"https://ddragon.leagueoflegends.com/realms/na.json"}, requested)
+}
+
+func TestNewClientWithVersion(t *testing.T) {
+	t.Parallel()
+	var requested []string
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			requested = append(requested, r.URL.Path)
+			return mock.NewJSONMockDoer(dataDragonResponse{Data: map[string]Item{"1001": {Name: "Boots"}}}, 200).Do(r)
+		},
+	}
+	c, err := NewClientWithVersion(doer, "9.10.1", LanguageCodeGermany, log.StandardLogger(),
+		WithCacheTTL(time.Nanosecond))
+	require.Nil(t, err)
+	assert.Equal(t, "9.10.1", c.GetVersion())
+	assert.Equal(t, languageCode(LanguageCodeGermany), c.GetLanguage())
+	time.Sleep(time.Millisecond)
+	_, err = c.GetItem("1001")
+	require.Nil(t, err)
+	assert.Equal(t, []string{"/cdn/9.10.1/data/de_DE/item.json"}, requested)
+	c, err = NewClientWithVersion(doer, "latest", LanguageCodeGermany, log.StandardLogger())
+	assert.Equal(t, fmt.Errorf("invalid version latest"), err)
+	assert.Nil(t, c)
+}
+
+func TestClient_GetVersionForRegion(t *testing.T) {
 	t.Parallel()
+	c := NewClient(endpointResponseDoer(map[string]interface{}{
+		"/realms/euw.json": map[string]string{"v": "13.23.1", "l": "en_GB"},
+		"/realms/kr.json":  map[string]string{"v": "13.24.1", "l": "ko_KR"},
+	}), api.RegionEuropeWest, log.StandardLogger())
 	tests := []struct {
 		name    string
-		doer    internal.Doer
-		want    []ChampionData
-		wantErr error
+		region  api.Region
+		want    string
+		wantErr bool
+	}{
+		{name: "other region", region: api.RegionKorea, want: "13.24.1"},
+		{name: "own region", region: api.RegionEuropeWest, want: "13.23.1"},
+		{name: "no realm", region: api.RegionTurkey, wantErr: true},
+		{name: "unknown region", region: api.Region("xx"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.GetVersionForRegion(tt.region)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, "13.23.1", c.GetVersion())
+		})
+	}
+}
+
+func TestClient_EnsureInitialized(t *testing.T) {
+	t.Parallel()
+	var realmRequests int32
+	responses := map[string]interface{}{
+		"/item.json": dataDragonResponse{Data: map[string]Item{"item": {}}},
+	}
+	doer := endpointResponseDoer(responses)
+	c := NewClient(&mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			if strings.HasPrefix(r.URL.Path, "/realms/") {
+				atomic.AddInt32(&realmRequests, 1)
+			}
+			return doer.Do(r)
+		},
+	}, api.RegionEuropeWest, log.StandardLogger())
+	require.Equal(t, fallbackVersion, c.GetVersion())
+	_, err := c.GetItems()
+	require.Nil(t, err)
+	assert.NotNil(t, c.EnsureInitialized(context.Background()))
+	assert.Equal(t, fallbackVersion, c.GetVersion())
+	assert.Len(t, c.items, 1)
+
+	responses["/realms/euw.json"] = map[string]string{"v": "13.24.1", "l": "de_DE"}
+	require.Nil(t, c.EnsureInitialized(context.Background()))
+	assert.Equal(t, "13.24.1", c.GetVersion())
+	assert.Equal(t, languageCode(LanguageCodeGermany), c.GetLanguage())
+	assert.Len(t, c.items, 0)
+	require.Nil(t, c.EnsureInitialized(context.Background()))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&realmRequests))
+}
+
+func TestRealmRegionFor(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		region api.Region
+		want   string
+		wantOK bool
+	}{
+		{region: api.RegionEuropeWest, want: "euw", wantOK: true},
+		{region: api.RegionVietnam, want: "vn", wantOK: true},
+		{region: api.RegionPhilippines, want: "ph", wantOK: true},
+		{region: api.RegionMiddleEast, want: "me", wantOK: true},
+		{region: api.Region("xx")},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.region), func(t *testing.T) {
+			got, ok := RealmRegionFor(tt.region)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_RefreshVersion(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		realm       interface{}
+		want        string
+		wantChanged bool
+		wantErr     bool
 	}{
 		{
-			name: "get response",
-			doer: dataDragonResponseDoer(map[string]ChampionData{
-				"champion": {},
-			}),
-			want: []ChampionData{{}},
+			name:        "new version",
+			realm:       map[string]string{"v": "9.11.1", "l": "ko_KR"},
+			want:        "9.11.1",
+			wantChanged: true,
 		},
 		{
-			name:    "known error",
-			doer:    mock.NewStatusMockDoer(http.StatusForbidden),
-			wantErr: api.ErrForbidden,
+			name:  "same version",
+			realm: map[string]string{"v": "9.10.1", "l": "ko_KR"},
+			want:  "9.10.1",
 		},
 		{
-			name: "unknown error",
-			doer: mock.NewStatusMockDoer(999),
-			wantErr: api.Error{
-				Message:    "unknown error reason",
-				StatusCode: 999,
-			},
+			name:    "error",
+			want:    "9.10.1",
+			wantErr: true,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			c := NewClient(tt.doer, api.RegionEuropeWest, log.StandardLogger())
-			got, err := c.GetChampions()
-			assert.Equal(t, tt.wantErr, err)
-			if tt.wantErr == nil {
-				assert.Equal(t, tt.want, got)
-				got, err := c.GetChampions()
-				assert.Nil(t, err)
-				assert.Equal(t, tt.want, got)
+			responses := map[string]interface{}{
+				"/item.json": dataDragonResponse{Data: map[string]Item{"item": {}}},
+			}
+			c := NewClient(endpointResponseDoer(responses), api.RegionEuropeWest, log.StandardLogger())
+			require.Equal(t, "9.10.1", c.version)
+			_, err := c.GetItems()
+			require.Nil(t, err)
+			if tt.realm != nil {
+				responses["/realms/euw.json"] = tt.realm
 			}
+			changed, err := c.RefreshVersion()
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.wantChanged, changed)
+			assert.Equal(t, tt.want, c.version)
+			assert.Equal(t, languageCode(LanguageCodeUnitedStates), c.language)
+			assert.Equal(t, tt.wantChanged, len(c.items) == 0)
 		})
 	}
 }
 
-func TestClient_GetChampion(t *testing.T) {
+func TestClient_RefreshVersion_concurrent(t *testing.T) {
+	t.Parallel()
+	doer := endpointResponseDoer(map[string]interface{}{
+		"/realms/euw.json": map[string]string{"v": "9.11.1", "l": "en_US"},
+		"/item.json":       dataDragonResponse{Data: map[string]Item{"item": {}}},
+	})
+	c := NewClient(doer, api.RegionEuropeWest, log.StandardLogger())
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, err := c.RefreshVersion()
+			assert.Nil(t, err)
+		}()
+		go func() {
+			defer wg.Done()
+			_, err := c.GetItems()
+			assert.Nil(t, err)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestClient_SetVersion(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		version     string
+		want        string
+		wantErr     bool
+		wantCleared bool
+	}{
+		{
+			name:        "new version",
+			version:     "7.10.1",
+			want:        "7.10.1",
+			wantCleared: true,
+		},
+		{
+			name:    "same version",
+			version: "9.10.1",
+			want:    "9.10.1",
+		},
+		{
+			name:    "too few components",
+			version: "9.10",
+			want:    "9.10.1",
+			wantErr: true,
+		},
+		{
+			name:    "non numeric component",
+			version: "9.a.1",
+			want:    "9.10.1",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(dataDragonResponseDoer(map[string]Item{"item": {}}), api.RegionEuropeWest,
+				log.StandardLogger())
+			c.version = "9.10.1"
+			_, err := c.GetItems()
+			require.Nil(t, err)
+			err = c.SetVersion(tt.version)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.want, c.version)
+			assert.Equal(t, tt.wantCleared, len(c.items) == 0)
+		})
+	}
+}
+
+func TestClient_GetVersion(t *testing.T) {
+	t.Parallel()
+	c := newClient(&mock.Doer{}, log.StandardLogger())
+	require.Nil(t, c.SetVersion("9.10.1"))
+	require.Nil(t, c.SetLanguage(LanguageCodeGermany))
+	assert.Equal(t, "9.10.1", c.GetVersion())
+	assert.Equal(t, languageCode(LanguageCodeGermany), c.GetLanguage())
+	// reading the version while it is changed must not race
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			_ = c.SetVersion(fmt.Sprintf("9.%d.1", i))
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			assert.True(t, isValidVersion(c.GetVersion()))
+		}
+	}()
+	wg.Wait()
+	assert.Equal(t, "9.99.1", c.GetVersion())
+}
+
+func TestClient_SetLanguage(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		code        languageCode
+		want        languageCode
+		wantErr     bool
+		wantCleared bool
+	}{
+		{
+			name:        "new language",
+			code:        LanguageCodeKorea,
+			want:        LanguageCodeKorea,
+			wantCleared: true,
+		},
+		{
+			name: "same language",
+			code: LanguageCodeUnitedStates,
+			want: LanguageCodeUnitedStates,
+		},
+		{
+			name:    "unknown language",
+			code:    "xx_XX",
+			want:    LanguageCodeUnitedStates,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(dataDragonResponseDoer(map[string]Item{"item": {}}), api.RegionEuropeWest,
+				log.StandardLogger())
+			c.language = LanguageCodeUnitedStates
+			_, err := c.GetItems()
+			require.Nil(t, err)
+			err = c.SetLanguage(tt.code)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.want, c.language)
+			assert.Equal(t, tt.wantCleared, len(c.items) == 0)
+		})
+	}
+}
+
+func TestClient_GetVersions(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
 		name    string
 		doer    internal.Doer
-		want    ChampionDataExtended
+		want    []string
 		wantErr error
 	}{
 		{
 			name: "get response",
-			doer: dataDragonResponseDoer(map[string]ChampionDataExtended{
-				"champion": {},
-			}),
-			want: ChampionDataExtended{},
-		},
-		{
-			name:    "not found",
-			doer:    mock.NewJSONMockDoer(struct{}{}, 200),
-			wantErr: api.ErrNotFound,
+			doer: mock.NewJSONMockDoer([]string{"9.10.1", "9.9.1"}, 200),
+			want: []string{"9.10.1", "9.9.1"},
 		},
 		{
 			name:    "known error",
@@ -101,11 +370,11 @@ func TestClient_GetChampion(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			c := NewClient(tt.doer, api.RegionEuropeWest, log.StandardLogger())
-			got, err := c.GetChampion("champion")
+			got, err := c.GetVersions()
 			assert.Equal(t, tt.wantErr, err)
 			if tt.wantErr == nil {
 				assert.Equal(t, tt.want, got)
-				got, err := c.GetChampion("champion")
+				got, err := c.GetVersions()
 				assert.Nil(t, err)
 				assert.Equal(t, tt.want, got)
 			}
@@ -113,20 +382,18 @@ func TestClient_GetChampion(t *testing.T) {
 	}
 }
 
-func TestClient_GetProfileIcons(t *testing.T) {
+func TestClient_GetLanguages(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
 		name    string
 		doer    internal.Doer
-		want    []ProfileIcon
+		want    []languageCode
 		wantErr error
 	}{
 		{
 			name: "get response",
-			doer: dataDragonResponseDoer(map[string]ProfileIcon{
-				"icon": {},
-			}),
-			want: []ProfileIcon{{}},
+			doer: mock.NewJSONMockDoer([]string{"en_US", "ko_KR"}, 200),
+			want: []languageCode{LanguageCodeUnitedStates, LanguageCodeKorea},
 		},
 		{
 			name:    "known error",
@@ -145,11 +412,11 @@ func TestClient_GetProfileIcons(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			c := NewClient(tt.doer, api.RegionEuropeWest, log.StandardLogger())
-			got, err := c.GetProfileIcons()
+			got, err := c.GetLanguages()
 			assert.Equal(t, tt.wantErr, err)
 			if tt.wantErr == nil {
 				assert.Equal(t, tt.want, got)
-				got, err := c.GetProfileIcons()
+				got, err := c.GetLanguages()
 				assert.Nil(t, err)
 				assert.Equal(t, tt.want, got)
 			}
@@ -157,20 +424,20 @@ func TestClient_GetProfileIcons(t *testing.T) {
 	}
 }
 
-func TestClient_GetItems(t *testing.T) {
+func TestClient_GetChampions(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
 		name    string
 		doer    internal.Doer
-		want    []Item
+		want    []ChampionData
 		wantErr error
 	}{
 		{
 			name: "get response",
-			doer: dataDragonResponseDoer(map[string]Item{
-				"item": {},
+			doer: dataDragonResponseDoer(map[string]ChampionData{
+				"champion": {},
 			}),
-			want: []Item{{ID: "item"}},
+			want: []ChampionData{{}},
 		},
 		{
 			name:    "known error",
@@ -189,11 +456,11 @@ func TestClient_GetItems(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			c := NewClient(tt.doer, api.RegionEuropeWest, log.StandardLogger())
-			got, err := c.GetItems()
+			got, err := c.GetChampions()
 			assert.Equal(t, tt.wantErr, err)
 			if tt.wantErr == nil {
 				assert.Equal(t, tt.want, got)
-				got, err := c.GetItems()
+				got, err := c.GetChampions()
 				assert.Nil(t, err)
 				assert.Equal(t, tt.want, got)
 			}
@@ -201,87 +468,75 @@ func TestClient_GetItems(t *testing.T) {
 	}
 }
 
-func TestClient_GetRunes(t *testing.T) {
+func TestClient_GetChampionsByID(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
 		name    string
 		doer    internal.Doer
-		want    []Item
+		want    map[string]ChampionData
 		wantErr error
 	}{
 		{
 			name: "get response",
-			doer: dataDragonResponseDoer(map[string]Item{
-				"rune": {},
+			doer: dataDragonResponseDoer(map[string]ChampionData{
+				"Aatrox": {ID: "Aatrox", Key: "266", Name: "Aatrox"},
+				"Ahri":   {ID: "Ahri", Key: "103", Name: "Ahri"},
 			}),
-			want: []Item{{ID: "rune"}},
+			want: map[string]ChampionData{
+				"266": {ID: "Aatrox", Key: "266", Name: "Aatrox"},
+				"103": {ID: "Ahri", Key: "103", Name: "Ahri"},
+			},
 		},
 		{
 			name:    "known error",
 			doer:    mock.NewStatusMockDoer(http.StatusForbidden),
 			wantErr: api.ErrForbidden,
 		},
-		{
-			name: "unknown error",
-			doer: mock.NewStatusMockDoer(999),
-			wantErr: api.Error{
-				Message:    "unknown error reason",
-				StatusCode: 999,
-			},
-		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			c := NewClient(tt.doer, api.RegionEuropeWest, log.StandardLogger())
-			got, err := c.GetRunes()
+			got, err := c.GetChampionsByID()
 			assert.Equal(t, tt.wantErr, err)
-			if tt.wantErr == nil {
-				assert.Equal(t, tt.want, got)
-				got, err := c.GetRunes()
-				assert.Nil(t, err)
-				assert.Equal(t, tt.want, got)
-			}
+			assert.Equal(t, tt.want, got)
 		})
 	}
 }
 
-func TestClient_GetMasteries(t *testing.T) {
+func TestClient_GetChampionsByName(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
 		name    string
 		doer    internal.Doer
-		want    []Mastery
+		want    map[string]ChampionData
 		wantErr error
 	}{
 		{
 			name: "get response",
-			doer: dataDragonResponseDoer(map[string]Mastery{
-				"mastery": {},
+			doer: dataDragonResponseDoer(map[string]ChampionData{
+				"Aatrox": {ID: "Aatrox", Key: "266", Name: "Aatrox"},
+				"Ahri":   {ID: "Ahri", Key: "103", Name: "Ahri"},
 			}),
-			want: []Mastery{{}},
+			want: map[string]ChampionData{
+				"Aatrox": {ID: "Aatrox", Key: "266", Name: "Aatrox"},
+				"Ahri":   {ID: "Ahri", Key: "103", Name: "Ahri"},
+			},
 		},
 		{
 			name:    "known error",
 			doer:    mock.NewStatusMockDoer(http.StatusForbidden),
 			wantErr: api.ErrForbidden,
 		},
-		{
-			name: "unknown error",
-			doer: mock.NewStatusMockDoer(999),
-			wantErr: api.Error{
-				Message:    "unknown error reason",
-				StatusCode: 999,
-			},
-		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			c := NewClient(tt.doer, api.RegionEuropeWest, log.StandardLogger())
-			got, err := c.GetMasteries()
+			got, err := c.GetChampionsByName()
 			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
 			if tt.wantErr == nil {
-				assert.Equal(t, tt.want, got)
-				got, err := c.GetMasteries()
+				delete(got, "Aatrox")
+				got, err := c.GetChampionsByName()
 				assert.Nil(t, err)
 				assert.Equal(t, tt.want, got)
 			}
@@ -289,20 +544,25 @@ func TestClient_GetMasteries(t *testing.T) {
 	}
 }
 
-func TestClient_GetSummonerSpells(t *testing.T) {
+func TestClient_GetChampion(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
 		name    string
 		doer    internal.Doer
-		want    []SummonerSpell
+		want    ChampionDataExtended
 		wantErr error
 	}{
 		{
 			name: "get response",
-			doer: dataDragonResponseDoer(map[string]SummonerSpell{
-				"summoner": {},
+			doer: dataDragonResponseDoer(map[string]ChampionDataExtended{
+				"champion": {},
 			}),
-			want: []SummonerSpell{{}},
+			want: ChampionDataExtended{},
+		},
+		{
+			name:    "not found",
+			doer:    mock.NewJSONMockDoer(struct{}{}, 200),
+			wantErr: api.ErrNotFound,
 		},
 		{
 			name:    "known error",
@@ -321,11 +581,11 @@ func TestClient_GetSummonerSpells(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			c := NewClient(tt.doer, api.RegionEuropeWest, log.StandardLogger())
-			got, err := c.GetSummonerSpells()
+			got, err := c.GetChampion("champion")
 			assert.Equal(t, tt.wantErr, err)
 			if tt.wantErr == nil {
 				assert.Equal(t, tt.want, got)
-				got, err := c.GetSummonerSpells()
+				got, err := c.GetChampion("champion")
 				assert.Nil(t, err)
 				assert.Equal(t, tt.want, got)
 			}
@@ -333,73 +593,2646 @@ func TestClient_GetSummonerSpells(t *testing.T) {
 	}
 }
 
-func TestClient_ClearCaches(t *testing.T) {
+func TestClient_GetChampion_caseInsensitive(t *testing.T) {
 	t.Parallel()
-	c := NewClient(http.DefaultClient, api.RegionKorea, log.StandardLogger())
-	c.ClearCaches()
-}
-
-func TestClient_GetChampionByID(t *testing.T) {
-	type test struct {
+	aatrox := ChampionData{ID: "Aatrox", Key: "266", Name: "Aatrox"}
+	responses := map[string]interface{}{
+		"/champion.json": dataDragonResponse{Data: map[string]ChampionData{"Aatrox": aatrox}},
+		"/champion/Aatrox.json": dataDragonResponse{Data: map[string]ChampionDataExtended{
+			"Aatrox": {ChampionData: aatrox, Lore: "lore"},
+		}},
+	}
+	tests := []struct {
 		name    string
-		doer    internal.Doer
-		id      string
-		want    ChampionDataExtended
+		input   string
+		want    string
 		wantErr error
-	}
-	tests := []test{
+	}{
 		{
-			name: "get response",
-			doer: dataDragonResponseDoer(map[string]ChampionData{
-				"champion": {Name: "champion", ID: "id"},
-			}),
-			id:   "id",
-			want: ChampionDataExtended{ChampionData: ChampionData{Name: "champion", ID: "id"}},
+			name:  "exact",
+			input: "Aatrox",
+			want:  "lore",
 		},
 		{
-			name:    "not found",
-			doer:    dataDragonResponseDoer(map[string]ChampionData{}),
-			wantErr: api.ErrNotFound,
+			name:  "lower case",
+			input: "aatrox",
+			want:  "lore",
 		},
 		{
-			name: "unknown error",
-			doer: mock.NewStatusMockDoer(999),
-			wantErr: api.Error{
-				Message:    "unknown error reason",
-				StatusCode: 999,
-			},
+			name:  "upper case",
+			input: "AATROX",
+			want:  "lore",
+		},
+		{
+			name:    "unknown",
+			input:   "zed",
+			wantErr: api.ErrNotFound,
 		},
 	}
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
-			client := NewClient(test.doer, api.RegionEuropeWest, log.StandardLogger())
-			got, err := client.GetChampionByID(test.id)
-			assert.Equal(t, test.wantErr, err)
-			assert.Equal(t, test.want, got)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newClient(endpointResponseDoer(responses), log.StandardLogger())
+			c.version, c.language = "9.10.1", LanguageCodeUnitedStates
+			got, err := c.GetChampion(tt.input)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got.Lore)
 		})
 	}
 }
 
-func TestClient_GetProfileIcon(t *testing.T) {
-	type test struct {
-		name    string
-		doer    internal.Doer
-		id      int
-		want    ProfileIcon
-		wantErr error
-	}
-	tests := []test{
+func TestClient_GetChampion_forbidden(t *testing.T) {
+	t.Parallel()
+	aatrox := ChampionData{ID: "Aatrox", Key: "266", Name: "Aatrox"}
+	responder := endpointResponseDoer(map[string]interface{}{
+		"/champion.json": dataDragonResponse{Data: map[string]ChampionData{"Aatrox": aatrox}},
+		"/champion/Aatrox.json": dataDragonResponse{Data: map[string]ChampionDataExtended{
+			"Aatrox": {ChampionData: aatrox, Lore: "lore"},
+		}},
+	})
+	// Data Dragon responds with 403 for files which do not exist
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			response, err := responder.Do(r)
+			if err == nil && response.StatusCode == http.StatusNotFound {
+				response.StatusCode = http.StatusForbidden
+			}
+			return response, err
+		},
+	}
+	c := newClient(doer, log.StandardLogger())
+	c.version, c.language = "13.24.1", LanguageCodeUnitedStates
+	got, err := c.GetChampion("aatrox")
+	require.Nil(t, err)
+	assert.Equal(t, "lore", got.Lore)
+	_, err = c.GetChampion("zed")
+	assert.Equal(t, api.ErrNotFound, err)
+}
+
+func TestClient_GetChampion_concurrent(t *testing.T) {
+	t.Parallel()
+	var requests int32
+	release := make(chan struct{})
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			if strings.HasSuffix(r.URL.Path, "/realms/euw.json") {
+				return &http.Response{StatusCode: http.StatusNotFound}, nil
+			}
+			if strings.HasSuffix(r.URL.Path, "/champion/champion.json") {
+				atomic.AddInt32(&requests, 1)
+				<-release
+			}
+			buffer, _ := json.Marshal(dataDragonResponse{Data: map[string]ChampionDataExtended{
+				"champion": {Lore: "lore"},
+			}})
+			return &http.Response{StatusCode: http.StatusOK, Body: &mock.ResponseBody{Content: buffer}}, nil
+		},
+	}
+	c := NewClient(doer, api.RegionEuropeWest, log.StandardLogger())
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got, err := c.GetChampion("champion")
+			assert.Nil(t, err)
+			assert.Equal(t, ChampionDataExtended{Lore: "lore"}, got)
+		}()
+	}
+	time.Sleep(10 * time.Millisecond)
+	// the champion caches must not be locked while the request is pending
+	c.championsMu.RLock()
+	c.championsMu.RUnlock()
+	close(release)
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+}
+
+func TestClient_GetChampion_cancelled(t *testing.T) {
+	t.Parallel()
+	started := make(chan struct{})
+	release := make(chan struct{})
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			if strings.HasSuffix(r.URL.Path, "/champion/champion.json") {
+				close(started)
+				<-release
+				if err := r.Context().Err(); err != nil {
+					return nil, err
+				}
+			}
+			buffer, _ := json.Marshal(dataDragonResponse{Data: map[string]ChampionDataExtended{
+				"champion": {Lore: "lore"},
+			}})
+			return &http.Response{StatusCode: http.StatusOK, Body: &mock.ResponseBody{Content: buffer}}, nil
+		},
+	}
+	c := newClient(doer, log.StandardLogger())
+	c.version, c.language = "13.24.1", LanguageCodeUnitedStates
+	ctx, cancel := context.WithCancel(context.Background())
+	cancelled := make(chan error, 1)
+	go func() {
+		_, err := c.GetChampionCtx(ctx, "champion")
+		cancelled <- err
+	}()
+	<-started
+	waiting := make(chan struct{})
+	go func() {
+		defer close(waiting)
+		got, err := c.GetChampion("champion")
+		assert.Nil(t, err)
+		assert.Equal(t, ChampionDataExtended{Lore: "lore"}, got)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	// the lookup which started the request returns once it is cancelled, the other one still receives the champion
+	cancel()
+	select {
+	case err := <-cancelled:
+		assert.Equal(t, context.Canceled, err)
+	case <-time.After(time.Second):
+		t.Error("lookup was not cancelled")
+	}
+	close(release)
+	<-waiting
+}
+
+func TestClient_concurrentFetch(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name  string
+		data  interface{}
+		fetch func(c *Client) (int, error)
+	}{
+		{
+			name: "items",
+			data: map[string]Item{"1001": {}, "1036": {}},
+			fetch: func(c *Client) (int, error) {
+				items, err := c.GetItems()
+				return len(items), err
+			},
+		},
+		{
+			name: "items and item",
+			data: map[string]Item{"1001": {}, "1036": {}},
+			fetch: func(c *Client) (int, error) {
+				if _, err := c.GetItem("1001"); err != nil {
+					return 0, err
+				}
+				items, err := c.GetItems()
+				return len(items), err
+			},
+		},
+		{
+			name: "champions",
+			data: map[string]ChampionData{"a": {Name: "a", Key: "1"}, "b": {Name: "b", Key: "2"}},
+			fetch: func(c *Client) (int, error) {
+				champions, err := c.GetChampions()
+				return len(champions), err
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var requests int32
+			doer := &mock.Doer{
+				Custom: func(r *http.Request) (*http.Response, error) {
+					if strings.HasSuffix(r.URL.Path, "/realms/euw.json") {
+						return &http.Response{StatusCode: http.StatusNotFound}, nil
+					}
+					atomic.AddInt32(&requests, 1)
+					time.Sleep(10 * time.Millisecond)
+					buffer, _ := json.Marshal(dataDragonResponse{Data: tt.data})
+					return &http.Response{StatusCode: http.StatusOK, Body: &mock.ResponseBody{Content: buffer}}, nil
+				},
+			}
+			c := NewClient(doer, api.RegionEuropeWest, log.StandardLogger())
+			var wg sync.WaitGroup
+			for i := 0; i < 20; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					got, err := tt.fetch(c)
+					assert.Nil(t, err)
+					assert.Equal(t, 2, got)
+				}()
+			}
+			wg.Wait()
+			assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+		})
+	}
+}
+
+func TestClient_GetChampionSkins(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		doer    internal.Doer
+		want    []SkinData
+		wantErr error
+	}{
+		{
+			name: "get response",
+			doer: dataDragonResponseDoer(map[string]ChampionDataExtended{
+				"champion": {Lore: "lore", Skins: []SkinData{{ID: "1000", Num: 0}, {ID: "1001", Num: 1}}},
+			}),
+			want: []SkinData{{ID: "1000", Num: 0}, {ID: "1001", Num: 1}},
+		},
+		{
+			name:    "not found",
+			doer:    mock.NewJSONMockDoer(struct{}{}, 200),
+			wantErr: api.ErrNotFound,
+		},
+		{
+			name:    "known error",
+			doer:    mock.NewStatusMockDoer(http.StatusForbidden),
+			wantErr: api.ErrForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(tt.doer, api.RegionEuropeWest, log.StandardLogger())
+			got, err := c.GetChampionSkins("champion")
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_GetChampionsExtended(t *testing.T) {
+	t.Parallel()
+	champions := map[string]ChampionDataExtended{
+		"Aatrox": {ChampionData: ChampionData{ID: "Aatrox", Name: "Aatrox"}, Lore: "aatrox lore"},
+		"Ahri":   {ChampionData: ChampionData{ID: "Ahri", Name: "Ahri"}, Lore: "ahri lore"},
+	}
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			if strings.HasSuffix(r.URL.Path, "/en_US/champion.json") {
+				data, err := json.Marshal(dataDragonResponse{Data: champions})
+				if err != nil {
+					return nil, err
+				}
+				return &http.Response{StatusCode: http.StatusOK, Body: &mock.ResponseBody{Content: data}}, nil
+			}
+			for name, champion := range champions {
+				if strings.HasSuffix(r.URL.Path, "/champion/"+name+".json") {
+					data, err := json.Marshal(dataDragonResponse{Data: map[string]ChampionDataExtended{name: champion}})
+					if err != nil {
+						return nil, err
+					}
+					return &http.Response{StatusCode: http.StatusOK, Body: &mock.ResponseBody{Content: data}}, nil
+				}
+			}
+			return &http.Response{StatusCode: http.StatusNotFound}, nil
+		},
+	}
+	tests := []struct {
+		name    string
+		names   []string
+		want    map[string]ChampionDataExtended
+		wantErr error
+	}{
+		{
+			name:  "get response",
+			names: []string{"Aatrox", "Ahri", "Aatrox"},
+			want:  champions,
+		},
+		{
+			name:  "no names",
+			names: nil,
+			want:  map[string]ChampionDataExtended{},
+		},
+		{
+			name:    "not found",
+			names:   []string{"Aatrox", "Unknown"},
+			wantErr: api.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(doer, api.RegionEuropeWest, log.StandardLogger())
+			got, err := c.GetChampionsExtended(tt.names...)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_GetAllChampionsExtended(t *testing.T) {
+	t.Parallel()
+	aatrox := ChampionDataExtended{ChampionData: ChampionData{ID: "Aatrox", Key: "266", Name: "Aatrox"}, Lore: "lore"}
+	wukong := ChampionDataExtended{
+		ChampionData: ChampionData{ID: "MonkeyKing", Key: "62", Name: "Wukong"},
+		Lore:         "lore",
+	}
+	tests := []struct {
+		name    string
+		doer    internal.Doer
+		want    map[string]ChampionDataExtended
+		wantErr error
+	}{
+		{
+			name: "get response",
+			doer: endpointResponseDoer(map[string]interface{}{
+				"/champion.json": dataDragonResponse{Data: map[string]ChampionData{
+					"Aatrox":     aatrox.ChampionData,
+					"MonkeyKing": wukong.ChampionData,
+				}},
+				"/champion/Aatrox.json": dataDragonResponse{Data: map[string]ChampionDataExtended{"Aatrox": aatrox}},
+				"/champion/MonkeyKing.json": dataDragonResponse{Data: map[string]ChampionDataExtended{
+					"MonkeyKing": wukong,
+				}},
+			}),
+			want: map[string]ChampionDataExtended{"Aatrox": aatrox, "MonkeyKing": wukong},
+		},
+		{
+			name: "champion missing",
+			doer: endpointResponseDoer(map[string]interface{}{
+				"/champion.json": dataDragonResponse{Data: map[string]ChampionData{
+					"Aatrox":     aatrox.ChampionData,
+					"MonkeyKing": wukong.ChampionData,
+				}},
+				"/champion/Aatrox.json": dataDragonResponse{Data: map[string]ChampionDataExtended{"Aatrox": aatrox}},
+			}),
+			wantErr: api.ErrNotFound,
+		},
+		{
+			name:    "list not found",
+			doer:    mock.NewStatusMockDoer(http.StatusNotFound),
+			wantErr: api.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(tt.doer, api.RegionEuropeWest, log.StandardLogger())
+			got, err := c.GetAllChampionsExtended()
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_GetRecommendedItems(t *testing.T) {
+	t.Parallel()
+	recommended := []RecommendedItemData{
+		{
+			Champion: "champion",
+			Title:    "champion build",
+			Map:      "SR",
+			Blocks: []RecommendedItemSet{
+				{Type: "starting", Items: []RecommendedItem{{ID: "1055", Count: 1}}},
+			},
+		},
+	}
+	tests := []struct {
+		name    string
+		doer    internal.Doer
+		want    []RecommendedItemData
+		wantErr error
+	}{
+		{
+			name: "get response",
+			doer: dataDragonResponseDoer(map[string]ChampionDataExtended{
+				"champion": {Lore: "lore", RecommendedItems: recommended},
+			}),
+			want: recommended,
+		},
+		{
+			name: "no recommended items",
+			doer: dataDragonResponseDoer(map[string]ChampionDataExtended{
+				"champion": {Lore: "lore"},
+			}),
+			want: []RecommendedItemData{},
+		},
+		{
+			name:    "not found",
+			doer:    mock.NewJSONMockDoer(struct{}{}, 200),
+			wantErr: api.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(tt.doer, api.RegionEuropeWest, log.StandardLogger())
+			got, err := c.GetRecommendedItems("champion")
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_GetSkin(t *testing.T) {
+	t.Parallel()
+	doer := endpointResponseDoer(map[string]interface{}{
+		"/champion.json": dataDragonResponse{Data: map[string]ChampionData{
+			"Aatrox":     {ID: "Aatrox", Key: "266", Name: "Aatrox"},
+			"MonkeyKing": {ID: "MonkeyKing", Key: "62", Name: "Wukong"},
+		}},
+		"/champion/Aatrox.json": dataDragonResponse{Data: map[string]ChampionDataExtended{
+			"Aatrox": {
+				ChampionData: ChampionData{ID: "Aatrox", Key: "266", Name: "Aatrox"},
+				Lore:         "lore",
+				Skins: []SkinData{
+					{ID: "266000", Name: "default"},
+					{ID: "266001", Num: 1, Name: "Justicar Aatrox"},
+				},
+			},
+		}},
+		"/champion/MonkeyKing.json": dataDragonResponse{Data: map[string]ChampionDataExtended{
+			"MonkeyKing": {
+				ChampionData: ChampionData{ID: "MonkeyKing", Key: "62", Name: "Wukong"},
+				Lore:         "lore",
+				Skins:        []SkinData{{ID: "62000", Name: "default"}},
+			},
+		}},
+	})
+	tests := []struct {
+		name         string
+		skinID       string
+		wantChampion ChampionData
+		wantSkin     SkinData
+		wantErr      error
+	}{
+		{
+			name:         "get response",
+			skinID:       "266001",
+			wantChampion: ChampionData{ID: "Aatrox", Key: "266", Name: "Aatrox"},
+			wantSkin:     SkinData{ID: "266001", Num: 1, Name: "Justicar Aatrox"},
+		},
+		{
+			name:         "id differs from name",
+			skinID:       "62000",
+			wantChampion: ChampionData{ID: "MonkeyKing", Key: "62", Name: "Wukong"},
+			wantSkin:     SkinData{ID: "62000", Name: "default"},
+		},
+		{
+			name:    "not found",
+			skinID:  "1",
+			wantErr: api.ErrNotFound,
+		},
+	}
+	c := NewClient(doer, api.RegionEuropeWest, log.StandardLogger())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			champion, skin, err := c.GetSkin(tt.skinID)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantChampion, champion)
+			assert.Equal(t, tt.wantSkin, skin)
+		})
+	}
+}
+
+func TestClient_GetSkin_versionRefresh(t *testing.T) {
+	t.Parallel()
+	aatrox := ChampionData{ID: "Aatrox", Key: "266", Name: "Aatrox"}
+	responder := endpointResponseDoer(map[string]interface{}{
+		"/champion.json": dataDragonResponse{Data: map[string]ChampionData{"Aatrox": aatrox}},
+		"/champion/Aatrox.json": dataDragonResponse{Data: map[string]ChampionDataExtended{
+			"Aatrox": {ChampionData: aatrox, Skins: []SkinData{{ID: "266001", Num: 1, Name: "Justicar Aatrox"}}},
+		}},
+	})
+	var realms int32
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			if strings.HasSuffix(r.URL.Path, "/realms/euw.json") {
+				// every refresh changes the version, which clears all caches including the skin index
+				version := fmt.Sprintf("13.%d.1", atomic.AddInt32(&realms, 1))
+				return endpointResponseDoer(map[string]interface{}{
+					"/realms/euw.json": map[string]string{"v": version, "l": "en_US"},
+				}).Do(r)
+			}
+			return responder.Do(r)
+		},
+	}
+	c := NewClient(doer, api.RegionEuropeWest, log.StandardLogger(), WithCacheTTL(time.Nanosecond))
+	done := make(chan error)
+	go func() {
+		_, _, err := c.GetSkin("266001")
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		assert.Nil(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("GetSkin did not return")
+	}
+}
+
+func TestClient_GetChampionTips(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		doer      internal.Doer
+		wantAlly  []string
+		wantEnemy []string
+		wantErr   error
+	}{
+		{
+			name: "get response",
+			doer: dataDragonResponseDoer(map[string]ChampionDataExtended{
+				"champion": {Lore: "lore", AllyTips: []string{"ally"}, EnemyTips: []string{"enemy", "other enemy"}},
+			}),
+			wantAlly:  []string{"ally"},
+			wantEnemy: []string{"enemy", "other enemy"},
+		},
+		{
+			name: "no tips",
+			doer: dataDragonResponseDoer(map[string]ChampionDataExtended{
+				"champion": {Lore: "lore"},
+			}),
+			wantAlly:  []string{},
+			wantEnemy: []string{},
+		},
+		{
+			name:    "not found",
+			doer:    mock.NewJSONMockDoer(struct{}{}, 200),
+			wantErr: api.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(tt.doer, api.RegionEuropeWest, log.StandardLogger())
+			ally, enemy, err := c.GetChampionTips("champion")
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantAlly, ally)
+			assert.Equal(t, tt.wantEnemy, enemy)
+		})
+	}
+}
+
+func TestClient_GetChampionSpell(t *testing.T) {
+	t.Parallel()
+	doer := dataDragonResponseDoer(map[string]ChampionDataExtended{
+		"champion": {
+			Lore:   "lore",
+			Spells: []SpellData{{ID: "championQ", Name: "q"}, {ID: "championW", Name: "w"}},
+		},
+	})
+	tests := []struct {
+		name     string
+		champion string
+		spellID  string
+		want     SpellData
+		wantErr  error
+	}{
+		{
+			name:     "get response",
+			champion: "champion",
+			spellID:  "championW",
+			want:     SpellData{ID: "championW", Name: "w"},
+		},
+		{
+			name:     "unknown spell",
+			champion: "champion",
+			spellID:  "championR",
+			wantErr:  api.ErrNotFound,
+		},
+		{
+			name:     "unknown champion",
+			champion: "other",
+			spellID:  "championQ",
+			wantErr:  api.ErrNotFound,
+		},
+	}
+	c := NewClient(doer, api.RegionEuropeWest, log.StandardLogger())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.GetChampionSpell(tt.champion, tt.spellID)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_ChampionStatsAtLevel(t *testing.T) {
+	t.Parallel()
+	doer := dataDragonResponseDoer(map[string]ChampionDataExtended{
+		"champion": {
+			ChampionData: ChampionData{Stats: ChampionDataStats{HealthPoints: 580, HealthPointsPerLevel: 90}},
+			Lore:         "lore",
+		},
+	})
+	tests := []struct {
+		name    string
+		level   int
+		want    ChampionStats
+		wantErr error
+	}{
+		{
+			name:  "level 18",
+			level: 18,
+			want:  ChampionStats{HealthPoints: 2110},
+		},
+		{
+			name:    "level too low",
+			level:   0,
+			wantErr: fmt.Errorf("invalid level 0"),
+		},
+		{
+			name:    "level too high",
+			level:   19,
+			wantErr: fmt.Errorf("invalid level 19"),
+		},
+	}
+	c := NewClient(doer, api.RegionEuropeWest, log.StandardLogger())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.ChampionStatsAtLevel("champion", tt.level)
+			assert.Equal(t, tt.wantErr, err)
+			assert.InDelta(t, tt.want.HealthPoints, got.HealthPoints, 1e-9)
+		})
+	}
+}
+
+func TestClient_GetProfileIcons(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		doer    internal.Doer
+		want    []ProfileIcon
+		wantErr error
+	}{
+		{
+			name: "get response",
+			doer: dataDragonResponseDoer(map[string]ProfileIcon{
+				"icon": {},
+			}),
+			want: []ProfileIcon{{}},
+		},
+		{
+			name:    "known error",
+			doer:    mock.NewStatusMockDoer(http.StatusForbidden),
+			wantErr: api.ErrForbidden,
+		},
+		{
+			name: "unknown error",
+			doer: mock.NewStatusMockDoer(999),
+			wantErr: api.Error{
+				Message:    "unknown error reason",
+				StatusCode: 999,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(tt.doer, api.RegionEuropeWest, log.StandardLogger())
+			got, err := c.GetProfileIcons()
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.want, got)
+				got, err := c.GetProfileIcons()
+				assert.Nil(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestClient_GetItems(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		doer    internal.Doer
+		want    []Item
+		wantErr error
+	}{
+		{
+			name: "get response",
+			doer: dataDragonResponseDoer(map[string]Item{
+				"item": {},
+			}),
+			want: []Item{{ID: "item"}},
+		},
+		{
+			name:    "known error",
+			doer:    mock.NewStatusMockDoer(http.StatusForbidden),
+			wantErr: api.ErrForbidden,
+		},
+		{
+			name: "unknown error",
+			doer: mock.NewStatusMockDoer(999),
+			wantErr: api.Error{
+				Message:    "unknown error reason",
+				StatusCode: 999,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(tt.doer, api.RegionEuropeWest, log.StandardLogger())
+			got, err := c.GetItems()
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.want, got)
+				got, err := c.GetItems()
+				assert.Nil(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestClient_counts(t *testing.T) {
+	t.Parallel()
+	responses := map[string]interface{}{
+		"/champion.json": dataDragonResponse{Data: map[string]ChampionData{
+			"Aatrox": {ID: "Aatrox", Key: "266"},
+			"Ahri":   {ID: "Ahri", Key: "103"},
+		}},
+		"/item.json": dataDragonResponse{Data: map[string]Item{"1001": {}, "1004": {}, "1006": {}}},
+		"/summoner.json": dataDragonResponse{Data: map[string]SummonerSpell{
+			"SummonerFlash": {ID: "SummonerFlash", Key: "4"},
+		}},
+	}
+	tests := []struct {
+		name    string
+		doer    internal.Doer
+		count   func(c *Client) (int, error)
+		want    int
+		wantErr error
+	}{
+		{
+			name:  "champions",
+			doer:  endpointResponseDoer(responses),
+			count: (*Client).GetChampionCount,
+			want:  2,
+		},
+		{
+			name:  "items",
+			doer:  endpointResponseDoer(responses),
+			count: (*Client).GetItemCount,
+			want:  3,
+		},
+		{
+			name:  "summoner spells",
+			doer:  endpointResponseDoer(responses),
+			count: (*Client).GetSummonerSpellCount,
+			want:  1,
+		},
+		{
+			name:    "known error",
+			doer:    mock.NewStatusMockDoer(http.StatusForbidden),
+			count:   (*Client).GetItemCount,
+			wantErr: api.ErrForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newClient(tt.doer, log.StandardLogger())
+			c.version, c.language = "9.10.1", LanguageCodeUnitedStates
+			got, err := tt.count(c)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_GetRunes(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		doer    internal.Doer
+		want    []Item
+		wantErr error
+	}{
+		{
+			name: "get response",
+			doer: dataDragonResponseDoer(map[string]Item{
+				"rune": {},
+			}),
+			want: []Item{{ID: "rune"}},
+		},
+		{
+			name:    "known error",
+			doer:    mock.NewStatusMockDoer(http.StatusForbidden),
+			wantErr: api.ErrForbidden,
+		},
+		{
+			name: "unknown error",
+			doer: mock.NewStatusMockDoer(999),
+			wantErr: api.Error{
+				Message:    "unknown error reason",
+				StatusCode: 999,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(tt.doer, api.RegionEuropeWest, log.StandardLogger(),
+				WithLegacyRuneVersion(latestRuneAndMasteryVersion))
+			got, err := c.GetRunes()
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.want, got)
+				got, err := c.GetRunes()
+				assert.Nil(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestClient_legacyRunesRemoved(t *testing.T) {
+	t.Parallel()
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			t.Errorf("unexpected request to %s", r.URL)
+			return &http.Response{StatusCode: http.StatusNotFound}, nil
+		},
+	}
+	c := newClient(doer, log.StandardLogger())
+	c.version, c.language = "7.24.1", LanguageCodeUnitedStates
+	_, err := c.GetRunes()
+	assert.Equal(t, ErrLegacyRunesRemoved, err)
+	_, err = c.GetMastery(6111)
+	assert.Equal(t, ErrLegacyRunesRemoved, err)
+	_, err = c.GetMasteryTrees()
+	assert.Equal(t, ErrLegacyRunesRemoved, err)
+	_, err = c.URL(URLFormatData, "/rune.json")
+	assert.Equal(t, ErrLegacyRunesRemoved, err)
+	// the version of the request is checked rather than the version of the client
+	_, err = c.newVersionedRequest(context.Background(), dataDragonDataURLFormat, "/rune.json", "7.23.1",
+		LanguageCodeUnitedStates)
+	assert.Nil(t, err)
+	c.version = "7.23.1"
+	_, err = c.newVersionedRequest(context.Background(), dataDragonDataURLFormat, "/rune.json", "7.24.1",
+		LanguageCodeUnitedStates)
+	assert.Equal(t, ErrLegacyRunesRemoved, err)
+}
+
+func TestClient_GetReforgedRunes(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		doer    internal.Doer
+		want    []RuneReforgedPath
+		wantErr error
+	}{
+		{
+			name: "get response",
+			doer: mock.NewJSONMockDoer([]RuneReforgedPath{
+				{ID: 8000, Slots: []RuneReforgedSlot{{Runes: []RuneReforged{{ID: 8005}}}}},
+			}, 200),
+			want: []RuneReforgedPath{
+				{ID: 8000, Slots: []RuneReforgedSlot{{Runes: []RuneReforged{{ID: 8005}}}}},
+			},
+		},
+		{
+			name:    "known error",
+			doer:    mock.NewStatusMockDoer(http.StatusForbidden),
+			wantErr: api.ErrForbidden,
+		},
+		{
+			name: "unknown error",
+			doer: mock.NewStatusMockDoer(999),
+			wantErr: api.Error{
+				Message:    "unknown error reason",
+				StatusCode: 999,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(tt.doer, api.RegionEuropeWest, log.StandardLogger())
+			got, err := c.GetReforgedRunes()
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.want, got)
+				got, err := c.GetReforgedRunes()
+				assert.Nil(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestClient_GetMasteries(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		doer    internal.Doer
+		want    []Mastery
+		wantErr error
+	}{
+		{
+			name: "get response",
+			doer: dataDragonResponseDoer(map[string]Mastery{
+				"mastery": {},
+			}),
+			want: []Mastery{{}},
+		},
+		{
+			name:    "known error",
+			doer:    mock.NewStatusMockDoer(http.StatusForbidden),
+			wantErr: api.ErrForbidden,
+		},
+		{
+			name: "unknown error",
+			doer: mock.NewStatusMockDoer(999),
+			wantErr: api.Error{
+				Message:    "unknown error reason",
+				StatusCode: 999,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(tt.doer, api.RegionEuropeWest, log.StandardLogger(),
+				WithLegacyRuneVersion(latestRuneAndMasteryVersion))
+			got, err := c.GetMasteries()
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.want, got)
+				got, err := c.GetMasteries()
+				assert.Nil(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestClient_GetMasteryTrees(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		doer    internal.Doer
+		want    []MasteryTree
+		wantErr error
+	}{
+		{
+			name: "get response",
+			doer: mock.NewJSONMockDoer(map[string]interface{}{
+				"data": map[string]Mastery{
+					"6111": {ID: 6111, Name: "Fury"},
+					"6114": {ID: 6114, Name: "Sorcery"},
+					"6211": {ID: 6211, Name: "Recovery"},
+				},
+				"tree": map[string]interface{}{
+					"Resolve": []interface{}{
+						map[string]interface{}{"masteryTreeItems": []interface{}{
+							nil,
+							map[string]int{"masteryId": 6211},
+						}},
+					},
+					"Ferocity": []interface{}{
+						map[string]interface{}{"masteryTreeItems": []interface{}{
+							map[string]int{"masteryId": 6111},
+							map[string]int{"masteryId": 6114},
+						}},
+						map[string]interface{}{"masteryTreeItems": []interface{}{nil}},
+					},
+				},
+			}, 200),
+			want: []MasteryTree{
+				{Name: "Ferocity", Tiers: [][]Mastery{{{ID: 6111, Name: "Fury"}, {ID: 6114, Name: "Sorcery"}}, nil}},
+				{Name: "Resolve", Tiers: [][]Mastery{{{ID: 6211, Name: "Recovery"}}}},
+			},
+		},
+		{
+			name:    "known error",
+			doer:    mock.NewStatusMockDoer(http.StatusForbidden),
+			wantErr: api.ErrForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(tt.doer, api.RegionEuropeWest, log.StandardLogger(),
+				WithLegacyRuneVersion(latestRuneAndMasteryVersion))
+			got, err := c.GetMasteryTrees()
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+			if tt.wantErr == nil {
+				masteries, err := c.GetMasteries()
+				assert.Nil(t, err)
+				assert.Len(t, masteries, 3)
+			}
+		})
+	}
+}
+
+func TestClient_GetMasteryTrees_empty(t *testing.T) {
+	t.Parallel()
+	requests := 0
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			requests++
+			return mock.NewJSONMockDoer(map[string]interface{}{"data": map[string]Mastery{}}, 200).Do(r)
+		},
+	}
+	c := newClient(doer, log.StandardLogger(), WithLegacyRuneVersion(latestRuneAndMasteryVersion))
+	c.version, c.language = "13.24.1", LanguageCodeUnitedStates
+	for i := 0; i < 2; i++ {
+		got, err := c.GetMasteryTrees()
+		require.Nil(t, err)
+		assert.Equal(t, []MasteryTree{}, got)
+	}
+	assert.Equal(t, 1, requests)
+}
+
+func TestClient_GetSummonerSpells(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		doer    internal.Doer
+		want    []SummonerSpell
+		wantErr error
+	}{
+		{
+			name: "get response",
+			doer: dataDragonResponseDoer(map[string]SummonerSpell{
+				"summoner": {},
+			}),
+			want: []SummonerSpell{{}},
+		},
+		{
+			name:    "known error",
+			doer:    mock.NewStatusMockDoer(http.StatusForbidden),
+			wantErr: api.ErrForbidden,
+		},
+		{
+			name: "unknown error",
+			doer: mock.NewStatusMockDoer(999),
+			wantErr: api.Error{
+				Message:    "unknown error reason",
+				StatusCode: 999,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(tt.doer, api.RegionEuropeWest, log.StandardLogger())
+			got, err := c.GetSummonerSpells()
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.want, got)
+				got, err := c.GetSummonerSpells()
+				assert.Nil(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestClient_maxConcurrency(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		options []Option
+		want    int32
+	}{
+		{name: "limited", options: []Option{WithMaxConcurrency(2)}, want: 2},
+		{name: "sequential", options: []Option{WithMaxConcurrency(1)}, want: 1},
+		{name: "default", want: 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			names := []string{"Aatrox", "Ahri", "Akali", "Akshan", "Alistar"}
+			list := map[string]ChampionData{}
+			responses := map[string]interface{}{"/en_US/champion.json": dataDragonResponse{Data: list}}
+			for _, name := range names {
+				list[name] = ChampionData{ID: name}
+				responses["/champion/"+name+".json"] = dataDragonResponse{Data: map[string]ChampionDataExtended{
+					name: {ChampionData: ChampionData{ID: name}, Lore: "lore"},
+				}}
+			}
+			responder := endpointResponseDoer(responses)
+			var running, maxRunning int32
+			doer := &mock.Doer{
+				Custom: func(r *http.Request) (*http.Response, error) {
+					n := atomic.AddInt32(&running, 1)
+					defer atomic.AddInt32(&running, -1)
+					for {
+						current := atomic.LoadInt32(&maxRunning)
+						if n <= current || atomic.CompareAndSwapInt32(&maxRunning, current, n) {
+							break
+						}
+					}
+					time.Sleep(20 * time.Millisecond)
+					return responder.Do(r)
+				},
+			}
+			c := newClient(doer, log.StandardLogger(), tt.options...)
+			c.version, c.language = "13.24.1", LanguageCodeUnitedStates
+			champions, err := c.GetChampionsExtended(names...)
+			require.Nil(t, err)
+			assert.Len(t, champions, len(names))
+			assert.Equal(t, tt.want, atomic.LoadInt32(&maxRunning))
+		})
+	}
+}
+
+func TestClient_Preload(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		doer    internal.Doer
+		wantErr error
+	}{
+		{
+			name: "preload",
+			doer: endpointResponseDoer(map[string]interface{}{
+				"/champion.json":      dataDragonResponse{Data: map[string]ChampionData{"champion": {Key: "1"}}},
+				"/item.json":          dataDragonResponse{Data: map[string]Item{"item": {}}},
+				"/summoner.json":      dataDragonResponse{Data: map[string]SummonerSpell{"summoner": {}}},
+				"/profileicon.json":   dataDragonResponse{Data: map[string]ProfileIcon{"icon": {}}},
+				"/runesReforged.json": []RuneReforgedPath{{ID: 8000}},
+			}),
+		},
+		{
+			name: "missing endpoint",
+			doer: endpointResponseDoer(map[string]interface{}{
+				"/champion.json": dataDragonResponse{Data: map[string]ChampionData{"champion": {Key: "1"}}},
+			}),
+			wantErr: api.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(tt.doer, api.RegionEuropeWest, log.StandardLogger())
+			err := c.Preload(context.Background())
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.Len(t, c.championsByID, 1)
+				assert.Len(t, c.items, 1)
+				assert.Len(t, c.summoners, 1)
+				assert.Len(t, c.profileIcons, 1)
+				assert.Len(t, c.reforgedRunes, 1)
+			}
+		})
+	}
+}
+
+func TestWithBaseURL(t *testing.T) {
+	t.Parallel()
+	var requested []string
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			requested = append(requested, r.URL.String())
+			return &http.Response{StatusCode: http.StatusNotFound}, nil
+		},
+	}
+	c := NewClient(doer, api.RegionEuropeWest, log.StandardLogger(), WithBaseURL("http://mirror.example.com/"))
+	_, _ = c.GetItems()
+	assert.Equal(t, []string{
+		"http://mirror.example.com/realms/euw.json",
+		"http://mirror.example.com/cdn/9.10.1/data/en_US/item.json",
+	}, requested)
+}
+
+func TestClient_GetRaw(t *testing.T) {
+	t.Parallel()
+	type tactician struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+	responses := map[string]interface{}{
+		"/tft-tactician.json": dataDragonResponse{Data: map[string]tactician{"1": {ID: "1", Name: "Silverwing"}}},
+		"/tft-unwrapped.json": []tactician{{ID: "2", Name: "Molediver"}},
+	}
+	tests := []struct {
+		name     string
+		endpoint string
+		target   func() interface{}
+		want     interface{}
+		wantErr  error
+	}{
+		{
+			name:     "data object",
+			endpoint: "/tft-tactician.json",
+			target:   func() interface{} { return &map[string]tactician{} },
+			want:     &map[string]tactician{"1": {ID: "1", Name: "Silverwing"}},
+		},
+		{
+			name:     "unwrapped",
+			endpoint: "/tft-unwrapped.json",
+			target:   func() interface{} { return &[]tactician{} },
+			want:     &[]tactician{{ID: "2", Name: "Molediver"}},
+		},
+		{
+			name:     "not found",
+			endpoint: "/missing.json",
+			target:   func() interface{} { return &[]tactician{} },
+			want:     &[]tactician{},
+			wantErr:  api.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newClient(endpointResponseDoer(responses), log.StandardLogger())
+			c.version, c.language = "13.24.1", LanguageCodeUnitedStates
+			got := tt.target()
+			err := c.GetRaw(tt.endpoint, got)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_URL(t *testing.T) {
+	t.Parallel()
+	c := newClient(mock.NewStatusMockDoer(http.StatusNotFound), log.StandardLogger())
+	c.version, c.language = "9.11.1", LanguageCodeGermany
+	mirror := newClient(mock.NewStatusMockDoer(http.StatusNotFound), log.StandardLogger(),
+		WithBaseURL("http://mirror.example.com"))
+	mirror.version, mirror.language = "9.11.1", LanguageCodeGermany
+	tests := []struct {
+		name     string
+		client   *Client
+		format   dataDragonURL
+		endpoint string
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:     "data",
+			client:   c,
+			format:   URLFormatData,
+			endpoint: "/champion.json",
+			want:     "https://ddragon.leagueoflegends.com/cdn/9.11.1/data/de_DE/champion.json",
+		},
+		{
+			name:     "legacy runes",
+			client:   c,
+			format:   URLFormatData,
+			endpoint: "/rune.json",
+			wantErr:  true,
+		},
+		{
+			name:     "image",
+			client:   c,
+			format:   URLFormatImage,
+			endpoint: "/item/1001.png",
+			want:     "https://ddragon.leagueoflegends.com/cdn/9.11.1/img/item/1001.png",
+		},
+		{
+			name:     "static image",
+			client:   c,
+			format:   URLFormatStaticImage,
+			endpoint: "/champion/splash/Aatrox_0.jpg",
+			want:     "https://ddragon.leagueoflegends.com/cdn/img/champion/splash/Aatrox_0.jpg",
+		},
+		{
+			name:     "base",
+			client:   c,
+			format:   URLFormatBase,
+			endpoint: "/api/versions.json",
+			want:     "https://ddragon.leagueoflegends.com/api/versions.json",
+		},
+		{
+			name:     "base url",
+			client:   mirror,
+			format:   URLFormatData,
+			endpoint: "/item.json",
+			want:     "http://mirror.example.com/cdn/9.11.1/data/de_DE/item.json",
+		},
+		{
+			name:     "invalid endpoint",
+			client:   c,
+			format:   URLFormatData,
+			endpoint: "/%zz",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.URL(tt.format, tt.endpoint)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWithHeaders(t *testing.T) {
+	t.Parallel()
+	var mu sync.Mutex
+	var userAgents []string
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			mu.Lock()
+			userAgents = append(userAgents, r.Header.Get("User-Agent"))
+			mu.Unlock()
+			return &http.Response{StatusCode: http.StatusNotFound}, nil
+		},
+	}
+	headers := map[string]string{"User-Agent": "golio"}
+	c := NewClient(doer, api.RegionEuropeWest, log.StandardLogger(), WithHeaders(headers))
+	headers["User-Agent"] = "changed"
+	_, _ = c.GetItems()
+	_, _ = c.GetImage(c.ItemImageURL(Item{ID: "1001"}))
+	_, _ = c.ImageExists(c.ItemImageURL(Item{ID: "1001"}))
+	assert.Equal(t, []string{"golio", "golio", "golio", "golio"}, userAgents)
+}
+
+func TestWithLogger(t *testing.T) {
+	t.Parallel()
+	logger, hook := logtest.NewNullLogger()
+	c := NewClient(mock.NewStatusMockDoer(http.StatusNotFound), api.Region("xx"), log.StandardLogger(),
+		WithLogger(logger.WithField("foo", "bar")))
+	require.NotNil(t, c)
+	require.Len(t, hook.AllEntries(), 1)
+	assert.Equal(t, log.Fields{"foo": "bar", "region": api.Region("xx")}, hook.LastEntry().Data)
+}
+
+func TestWithoutLogging(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		logger  log.FieldLogger
+		options []Option
+	}{
+		{
+			name:    "option",
+			logger:  log.StandardLogger(),
+			options: []Option{WithoutLogging()},
+		},
+		{
+			name: "nil logger",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(mock.NewStatusMockDoer(http.StatusNotFound), api.Region("xx"), tt.logger, tt.options...)
+			require.NotNil(t, c)
+			logger, ok := c.logger.(*log.Logger)
+			require.True(t, ok)
+			assert.Equal(t, ioutil.Discard, logger.Out)
+		})
+	}
+}
+
+func TestClient_gzip(t *testing.T) {
+	t.Parallel()
+	gzipped := func(object interface{}) []byte {
+		var buffer bytes.Buffer
+		writer := gzip.NewWriter(&buffer)
+		require.Nil(t, json.NewEncoder(writer).Encode(object))
+		require.Nil(t, writer.Close())
+		return buffer.Bytes()
+	}
+	tests := []struct {
+		name    string
+		body    []byte
+		header  http.Header
+		want    []Item
+		wantErr bool
+	}{
+		{
+			name:   "compressed",
+			body:   gzipped(dataDragonResponse{Data: map[string]Item{"1001": {Name: "Boots"}}}),
+			header: http.Header{"Content-Encoding": []string{"gzip"}},
+			want:   []Item{{ID: "1001", Name: "Boots"}},
+		},
+		{
+			name: "uncompressed",
+			body: []byte(`{"data":{"1001":{"name":"Boots"}}}`),
+			want: []Item{{ID: "1001", Name: "Boots", InStore: true}},
+		},
+		{
+			name:    "invalid compressed body",
+			body:    []byte("not gzip"),
+			header:  http.Header{"Content-Encoding": []string{"gzip"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doer := &mock.Doer{
+				Custom: func(r *http.Request) (*http.Response, error) {
+					assert.Equal(t, "gzip", r.Header.Get("Accept-Encoding"))
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Header:     tt.header,
+						Body:       &mock.ResponseBody{Content: tt.body},
+					}, nil
+				},
+			}
+			c := newClient(doer, log.StandardLogger())
+			got, err := c.GetItems()
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_conditionalRequest(t *testing.T) {
+	t.Parallel()
+	var full, notModified int32
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			if !strings.HasSuffix(r.URL.Path, "/item.json") {
+				return &http.Response{StatusCode: http.StatusNotFound}, nil
+			}
+			if r.Header.Get("If-None-Match") == `"etag"` {
+				atomic.AddInt32(&notModified, 1)
+				return &http.Response{StatusCode: http.StatusNotModified, Body: &mock.ResponseBody{}}, nil
+			}
+			atomic.AddInt32(&full, 1)
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Etag": []string{`"etag"`}},
+				Body:       &mock.ResponseBody{Content: []byte(`{"data":{"1001":{"name":"Boots"}}}`)},
+			}, nil
+		},
+	}
+	c := NewClient(doer, api.RegionEuropeWest, log.StandardLogger(), WithCacheTTL(time.Nanosecond))
+	for i := 0; i < 3; i++ {
+		items, err := c.GetItems()
+		require.Nil(t, err)
+		assert.Equal(t, []Item{{ID: "1001", Name: "Boots", InStore: true}}, items)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&full))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&notModified))
+	c.ClearCaches()
+	_, err := c.GetItems()
+	require.Nil(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&full))
+}
+
+func TestClient_conditionalRequest_notCached(t *testing.T) {
+	t.Parallel()
+	doer := mock.NewJSONMockDoer(dataDragonResponse{Data: map[string]Item{"1001": {Name: "Boots"}}},
+		http.StatusNotModified)
+	c := newClient(doer, log.StandardLogger())
+	c.version, c.language = "13.24.1", LanguageCodeUnitedStates
+	_, err := c.GetItems()
+	want := api.Error{Message: "not modified without a cached response", StatusCode: http.StatusNotModified}
+	assert.Equal(t, want, err)
+}
+
+func TestClient_conditionalRequest_otherVersion(t *testing.T) {
+	t.Parallel()
+	responder := endpointResponseDoer(map[string]interface{}{
+		"/api/versions.json": []string{"13.24.1", "13.23.1", "13.22.1"},
+		"/item.json":         dataDragonResponse{Data: map[string]Item{"1001": {Name: "Boots"}}},
+	})
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			response, err := responder.Do(r)
+			if err == nil {
+				response.Header = http.Header{"Etag": []string{`"etag"`}}
+			}
+			return response, err
+		},
+	}
+	c := newClient(doer, log.StandardLogger())
+	c.version, c.language = "13.24.1", LanguageCodeUnitedStates
+	_, err := c.ItemsAddedInVersion("13.23.1")
+	require.Nil(t, err)
+	_, err = c.ItemsAddedInVersion("13.24.1")
+	require.Nil(t, err)
+	var cached []string
+	for url := range c.responsesByURL {
+		cached = append(cached, url)
+	}
+	assert.Equal(t, []string{"https://ddragon.leagueoflegends.com/cdn/13.24.1/data/en_US/item.json"}, cached)
+}
+
+func TestWithTimeout(t *testing.T) {
+	t.Parallel()
+	hanging := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			<-r.Context().Done()
+			return nil, r.Context().Err()
+		},
+	}
+	tests := []struct {
+		name    string
+		doer    internal.Doer
+		want    []Item
+		wantErr error
+	}{
+		{
+			name:    "timeout",
+			doer:    hanging,
+			wantErr: context.DeadlineExceeded,
+		},
+		{
+			name: "get response",
+			doer: dataDragonResponseDoer(map[string]Item{"1001": {Name: "Boots"}}),
+			want: []Item{{ID: "1001", Name: "Boots"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newClient(tt.doer, log.StandardLogger(), WithTimeout(10*time.Millisecond))
+			got, err := c.GetItems()
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWithRetry(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		attempts     int
+		responses    []int
+		wantRequests int
+		wantErr      error
+	}{
+		{
+			name:         "success after server errors",
+			attempts:     3,
+			responses:    []int{http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK},
+			wantRequests: 3,
+		},
+		{
+			name:         "success after rate limit and network error",
+			attempts:     3,
+			responses:    []int{http.StatusTooManyRequests, 0, http.StatusOK},
+			wantRequests: 3,
+		},
+		{
+			name:         "attempts exceeded",
+			attempts:     2,
+			responses:    []int{http.StatusInternalServerError, http.StatusInternalServerError, http.StatusOK},
+			wantRequests: 2,
+			wantErr:      api.ErrInternalServerError,
+		},
+		{
+			name:         "not retryable",
+			attempts:     3,
+			responses:    []int{http.StatusForbidden, http.StatusOK},
+			wantRequests: 1,
+			wantErr:      api.ErrForbidden,
+		},
+		{
+			name:         "unknown error",
+			attempts:     3,
+			responses:    []int{1, http.StatusOK},
+			wantRequests: 1,
+			wantErr:      fmt.Errorf("invalid request"),
+		},
+		{
+			name:         "invalid gzip body",
+			attempts:     3,
+			responses:    []int{2, http.StatusOK},
+			wantRequests: 1,
+			wantErr:      gzip.ErrHeader,
+		},
+		{
+			name:         "disabled",
+			responses:    []int{http.StatusInternalServerError, http.StatusOK},
+			wantRequests: 1,
+			wantErr:      api.ErrInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var requests int
+			doer := &mock.Doer{
+				Custom: func(r *http.Request) (*http.Response, error) {
+					if strings.HasSuffix(r.URL.Path, "/realms/euw.json") {
+						return &http.Response{StatusCode: http.StatusNotFound}, nil
+					}
+					status := tt.responses[requests]
+					requests++
+					switch status {
+					case 0:
+						return nil, &url.Error{Op: "Get", URL: r.URL.String(), Err: fmt.Errorf("connection reset")}
+					case 1:
+						return nil, fmt.Errorf("invalid request")
+					case 2:
+						header := http.Header{"Content-Encoding": []string{"gzip"}}
+						body := &mock.ResponseBody{Content: []byte("not a gzip stream")}
+						return &http.Response{StatusCode: http.StatusOK, Header: header, Body: body}, nil
+					case http.StatusOK:
+						buffer, _ := json.Marshal(dataDragonResponse{Data: map[string]Item{"1001": {}}})
+						return &http.Response{StatusCode: status, Body: &mock.ResponseBody{Content: buffer}}, nil
+					default:
+						return &http.Response{StatusCode: status}, nil
+					}
+				},
+			}
+			c := NewClient(doer, api.RegionEuropeWest, log.StandardLogger(), WithRetry(tt.attempts, time.Millisecond))
+			_, err := c.GetItems()
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantRequests, requests)
+		})
+	}
+}
+
+// closeRecordingBody is a response body which records whether it was closed
+type closeRecordingBody struct {
+	mock.ResponseBody
+	closed int32
+}
+
+func (b *closeRecordingBody) Close() error {
+	atomic.StoreInt32(&b.closed, 1)
+	return b.ResponseBody.Close()
+}
+
+func TestWithRetry_closeBody(t *testing.T) {
+	t.Parallel()
+	var bodies []*closeRecordingBody
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			body := &closeRecordingBody{}
+			if strings.HasSuffix(r.URL.Path, "/realms/euw.json") {
+				return &http.Response{StatusCode: http.StatusNotFound, Body: body}, nil
+			}
+			bodies = append(bodies, body)
+			return &http.Response{StatusCode: http.StatusServiceUnavailable, Body: body}, nil
+		},
+	}
+	c := NewClient(doer, api.RegionEuropeWest, log.StandardLogger(), WithRetry(3, time.Millisecond))
+	_, err := c.GetItems()
+	assert.Equal(t, api.ErrServiceUnavailable, err)
+	require.Len(t, bodies, 3)
+	for _, body := range bodies {
+		assert.Equal(t, int32(1), atomic.LoadInt32(&body.closed))
+	}
+}
+
+func TestWithRetry_cancel(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			if strings.HasSuffix(r.URL.Path, "/realms/euw.json") {
+				return &http.Response{StatusCode: http.StatusNotFound}, nil
+			}
+			cancel()
+			return &http.Response{StatusCode: http.StatusServiceUnavailable}, nil
+		},
+	}
+	c := NewClient(doer, api.RegionEuropeWest, log.StandardLogger(), WithRetry(3, time.Hour))
+	_, err := c.GetItemsCtx(ctx)
+	assert.Equal(t, api.ErrServiceUnavailable, err)
+}
+
+func TestClient_retryAfter(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		retryAfter   string
+		attempts     int
+		backoff      time.Duration
+		wantRequests int
+		wantErr      error
+	}{
+		{
+			name:         "seconds",
+			retryAfter:   "120",
+			wantRequests: 1,
+			wantErr:      RateLimitError{RetryAfter: 2 * time.Minute},
+		},
+		{
+			name:         "date in the past",
+			retryAfter:   "Wed, 21 Oct 2015 07:28:00 GMT",
+			wantRequests: 1,
+			wantErr:      RateLimitError{},
+		},
+		{
+			name:         "invalid",
+			retryAfter:   "soon",
+			wantRequests: 1,
+			wantErr:      api.ErrRateLimitExceeded,
+		},
+		{
+			name:         "missing",
+			wantRequests: 1,
+			wantErr:      api.ErrRateLimitExceeded,
+		},
+		{
+			name:         "retry",
+			retryAfter:   "0",
+			attempts:     2,
+			wantRequests: 2,
+		},
+		{
+			name:         "longer than backoff",
+			retryAfter:   "120",
+			attempts:     3,
+			backoff:      time.Second,
+			wantRequests: 1,
+			wantErr:      RateLimitError{RetryAfter: 2 * time.Minute},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var requests int
+			doer := &mock.Doer{
+				Custom: func(r *http.Request) (*http.Response, error) {
+					if strings.HasSuffix(r.URL.Path, "/realms/euw.json") {
+						return &http.Response{StatusCode: http.StatusNotFound}, nil
+					}
+					requests++
+					if requests > 1 {
+						buffer, _ := json.Marshal(dataDragonResponse{Data: map[string]Item{"1001": {}}})
+						return &http.Response{StatusCode: http.StatusOK, Body: &mock.ResponseBody{Content: buffer}}, nil
+					}
+					header := http.Header{}
+					if tt.retryAfter != "" {
+						header.Set("Retry-After", tt.retryAfter)
+					}
+					return &http.Response{StatusCode: http.StatusTooManyRequests, Header: header}, nil
+				},
+			}
+			backoff := tt.backoff
+			if backoff == 0 {
+				backoff = time.Hour
+			}
+			c := NewClient(doer, api.RegionEuropeWest, log.StandardLogger(), WithRetry(tt.attempts, backoff))
+			_, err := c.GetItems()
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantRequests, requests)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, api.ErrRateLimitExceeded))
+			}
+		})
+	}
+}
+
+type observedRequest struct {
+	endpoint   string
+	statusCode int
+	err        error
+}
+
+type recordingMetricsHook struct {
+	mu       sync.Mutex
+	requests []observedRequest
+}
+
+func (h *recordingMetricsHook) ObserveRequest(endpoint string, duration time.Duration, statusCode int, err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.requests = append(h.requests, observedRequest{endpoint: endpoint, statusCode: statusCode, err: err})
+}
+
+func TestWithMetricsHook(t *testing.T) {
+	t.Parallel()
+	hook := &recordingMetricsHook{}
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			switch {
+			case strings.HasSuffix(r.URL.Path, "/item.json"):
+				buffer, _ := json.Marshal(dataDragonResponse{Data: map[string]Item{"1001": {}}})
+				return &http.Response{StatusCode: http.StatusOK, Body: &mock.ResponseBody{Content: buffer}}, nil
+			case strings.HasSuffix(r.URL.Path, "/summoner.json"):
+				return nil, fmt.Errorf("connection reset")
+			default:
+				return &http.Response{StatusCode: http.StatusNotFound}, nil
+			}
+		},
+	}
+	c := NewClient(doer, api.RegionEuropeWest, log.StandardLogger(), WithMetricsHook(hook))
+	_, _ = c.GetItems()
+	_, _ = c.GetSummonerSpells()
+	assert.Equal(t, []observedRequest{
+		{endpoint: "/realms/euw.json", statusCode: http.StatusNotFound, err: api.ErrNotFound},
+		{endpoint: "/item.json", statusCode: http.StatusOK},
+		{endpoint: "/summoner.json", err: fmt.Errorf("connection reset")},
+	}, hook.requests)
+}
+
+func TestWithCacheTTL(t *testing.T) {
+	t.Parallel()
+	var requests int
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			requests++
+			return endpointResponseDoer(map[string]interface{}{
+				"/item.json": dataDragonResponse{Data: map[string]Item{"item": {}}},
+			}).Do(r)
+		},
+	}
+	c := NewClient(doer, api.RegionEuropeWest, log.StandardLogger(), WithCacheTTL(time.Hour))
+	requests = 0
+	_, err := c.GetItems()
+	require.Nil(t, err)
+	_, err = c.GetItems()
+	require.Nil(t, err)
+	assert.Equal(t, 1, requests)
+	c.itemsUpdated = time.Now().Add(-2 * time.Hour)
+	_, err = c.GetItems()
+	require.Nil(t, err)
+	assert.Equal(t, 2, requests)
+}
+
+func TestClient_refreshVersionIfExpired(t *testing.T) {
+	t.Parallel()
+	version := "9.10.1"
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			return endpointResponseDoer(map[string]interface{}{
+				"/realms/euw.json": map[string]string{"v": version, "l": "en_US"},
+				"/item.json":       dataDragonResponse{Data: map[string]Item{"item": {}}},
+			}).Do(r)
+		},
+	}
+	c := NewClient(doer, api.RegionEuropeWest, log.StandardLogger(), WithCacheTTL(time.Hour))
+	require.Equal(t, "9.10.1", c.version)
+	_, err := c.GetItems()
+	require.Nil(t, err)
+	version = "9.11.1"
+	c.refreshVersionIfExpired(context.Background())
+	assert.Equal(t, "9.10.1", c.version)
+	assert.Len(t, c.items, 1)
+	c.versionUpdated = time.Now().Add(-2 * time.Hour)
+	c.refreshVersionIfExpired(context.Background())
+	assert.Equal(t, "9.11.1", c.version)
+	assert.Len(t, c.items, 0)
+}
+
+func TestClient_SetVersion_cacheTTL(t *testing.T) {
+	t.Parallel()
+	var requested []string
+	responder := endpointResponseDoer(map[string]interface{}{
+		"/realms/euw.json": map[string]string{"v": "13.24.1", "l": "en_US"},
+		"/item.json":       dataDragonResponse{Data: map[string]Item{"1001": {Name: "Boots"}}},
+	})
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			requested = append(requested, r.URL.Path)
+			return responder.Do(r)
+		},
+	}
+	c := NewClient(doer, api.RegionEuropeWest, log.StandardLogger(), WithCacheTTL(time.Nanosecond))
+	require.Nil(t, c.SetVersion("9.10.1"))
+	requested = nil
+	time.Sleep(time.Millisecond)
+	_, err := c.GetItems()
+	require.Nil(t, err)
+	assert.Equal(t, "9.10.1", c.GetVersion())
+	assert.Equal(t, []string{"/cdn/9.10.1/data/en_US/item.json"}, requested)
+}
+
+func TestClient_ClearCaches(t *testing.T) {
+	t.Parallel()
+	c := NewClient(http.DefaultClient, api.RegionKorea, log.StandardLogger())
+	c.ClearCaches()
+}
+
+func TestClient_clearCache(t *testing.T) {
+	t.Parallel()
+	aatrox := ChampionData{ID: "Aatrox", Key: "266", Name: "Aatrox"}
+	responses := map[string]interface{}{
+		"/champion.json": dataDragonResponse{Data: map[string]ChampionData{"Aatrox": aatrox}},
+		"/champion/Aatrox.json": dataDragonResponse{Data: map[string]ChampionDataExtended{
+			"Aatrox": {ChampionData: aatrox},
+		}},
+		"/item.json": dataDragonResponse{Data: map[string]Item{"1001": {Name: "Boots"}}},
+		"/summoner.json": dataDragonResponse{Data: map[string]SummonerSpell{
+			"SummonerFlash": {ID: "SummonerFlash", Key: "4"},
+		}},
+		"/tft-item.json": dataDragonResponse{Data: map[string]TFTItem{"TFT_Item": {ID: "TFT_Item"}}},
+	}
+	getters := map[string]func(c *Client) error{
+		"/champion.json": func(c *Client) error { _, err := c.GetChampionByID("266"); return err },
+		"/item.json":     func(c *Client) error { _, err := c.GetItem("1001"); return err },
+		"/summoner.json": func(c *Client) error { _, err := c.GetSummonerSpellByKey("4"); return err },
+		"/tft-item.json": func(c *Client) error { _, err := c.GetTFTItems(); return err },
+	}
+	tests := []struct {
+		name     string
+		clear    func(c *Client)
+		endpoint string
+	}{
+		{"champions", (*Client).ClearChampionCache, "/champion.json"},
+		{"items", (*Client).ClearItemCache, "/item.json"},
+		{"summoner spells", (*Client).ClearSummonerSpellCache, "/summoner.json"},
+		{"tft items", (*Client).ClearTFTItemCache, "/tft-item.json"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			requests := map[string]int{}
+			responder := endpointResponseDoer(responses)
+			doer := &mock.Doer{
+				Custom: func(r *http.Request) (*http.Response, error) {
+					mu.Lock()
+					requests[r.URL.Path[strings.LastIndex(r.URL.Path, "/"):]]++
+					mu.Unlock()
+					return responder.Do(r)
+				},
+			}
+			c := newClient(doer, log.StandardLogger())
+			c.version, c.language = "9.10.1", LanguageCodeUnitedStates
+			for _, get := range getters {
+				require.Nil(t, get(c))
+			}
+			tt.clear(c)
+			for _, get := range getters {
+				require.Nil(t, get(c))
+			}
+			for endpoint := range getters {
+				want := 1
+				if endpoint == tt.endpoint {
+					want = 2
+				}
+				assert.Equal(t, want, requests[endpoint], endpoint)
+			}
+		})
+	}
+}
+
+// TestClient_ClearCaches_refetch asserts that every getter is served from its cache until the caches are cleared,
+// either explicitly or by changing the version or language, after which the data is retrieved again
+func TestClient_ClearCaches_refetch(t *testing.T) {
+	t.Parallel()
+	aatrox := ChampionData{ID: "Aatrox", Key: "266", Name: "Aatrox"}
+	responses := map[string]interface{}{
+		"/api/versions.json":  []string{"9.11.1", "9.10.1"},
+		"/cdn/languages.json": []string{"en_US", "de_DE"},
+		"/champion.json":      dataDragonResponse{Data: map[string]ChampionData{"Aatrox": aatrox}},
+		"/champion/Aatrox.json": dataDragonResponse{Data: map[string]ChampionDataExtended{
+			"Aatrox": {ChampionData: aatrox, Lore: "lore", Skins: []SkinData{{ID: "266000"}}},
+		}},
+		"/profileicon.json": dataDragonResponse{Data: map[string]ProfileIcon{"1": {ID: 1}}},
+		"/item.json":        dataDragonResponse{Data: map[string]Item{"1001": {Name: "Boots"}}},
+		"/mastery.json": map[string]interface{}{
+			"data": map[string]Mastery{"6111": {ID: 6111}},
+			"tree": map[string]interface{}{"Ferocity": []interface{}{
+				map[string]interface{}{"masteryTreeItems": []interface{}{map[string]int{"masteryId": 6111}}},
+			}},
+		},
+		"/rune.json": dataDragonResponse{Data: map[string]Item{"5001": {}}},
+		"/runesReforged.json": []RuneReforgedPath{
+			{ID: 8000, Slots: []RuneReforgedSlot{{Runes: []RuneReforged{{ID: 8005}}}}},
+		},
+		"/summoner.json": dataDragonResponse{Data: map[string]SummonerSpell{
+			"SummonerFlash": {ID: "SummonerFlash", Key: "4"},
+		}},
+		"/map.json":          dataDragonResponse{Data: map[string]GameMap{"11": {ID: 11}}},
+		"/challenges.json":   []Challenge{{ID: 101000}},
+		"/tft-champion.json": dataDragonResponse{Data: map[string]TFTChampion{"TFT9_Ahri": {ID: "TFT9_Ahri"}}},
+		"/tft-item.json":     dataDragonResponse{Data: map[string]TFTItem{"TFT_Item": {ID: "TFT_Item"}}},
+		"/tft-trait.json":    dataDragonResponse{Data: map[string]TFTTrait{"Set9_Bastion": {ID: "Set9_Bastion"}}},
+		"/tft-augments.json": dataDragonResponse{Data: map[string]TFTAugment{"TFT9_Augment": {ID: "TFT9_Augment"}}},
+		"/tft-arena.json":    dataDragonResponse{Data: map[string]TFTArena{"1": {ID: "1"}}},
+		"/tft-queues.json":   dataDragonResponse{Data: map[string]TFTQueue{"1100": {ID: "1100"}}},
+		"/tft-regalia.json": dataDragonResponse{Data: map[string]map[string]TFTRegalia{
+			"RANKED_TFT": {"Gold": {}},
+		}},
+	}
+	tests := []struct {
+		name     string
+		endpoint string
+		get      func(c *Client) error
+	}{
+		{"versions", "/api/versions.json", func(c *Client) error { _, err := c.GetVersions(); return err }},
+		{"languages", "/cdn/languages.json", func(c *Client) error { _, err := c.GetLanguages(); return err }},
+		{"champions", "/champion.json", func(c *Client) error { _, err := c.GetChampions(); return err }},
+		{"champion by id", "/champion.json", func(c *Client) error { _, err := c.GetChampionByID("266"); return err }},
+		{"champion", "/champion/Aatrox.json", func(c *Client) error { _, err := c.GetChampion("Aatrox"); return err }},
+		{"skin", "/champion/Aatrox.json", func(c *Client) error { _, _, err := c.GetSkin("266000"); return err }},
+		{"profile icons", "/profileicon.json", func(c *Client) error { _, err := c.GetProfileIcons(); return err }},
+		{"profile icon", "/profileicon.json", func(c *Client) error { _, err := c.GetProfileIcon(1); return err }},
+		{"items", "/item.json", func(c *Client) error { _, err := c.GetItems(); return err }},
+		{"item", "/item.json", func(c *Client) error { _, err := c.GetItem("1001"); return err }},
+		{"masteries", "/mastery.json", func(c *Client) error { _, err := c.GetMasteries(); return err }},
+		{"mastery trees", "/mastery.json", func(c *Client) error { _, err := c.GetMasteryTrees(); return err }},
+		{"runes", "/rune.json", func(c *Client) error { _, err := c.GetRunes(); return err }},
+		{"reforged runes", "/runesReforged.json", func(c *Client) error { _, err := c.GetReforgedRunes(); return err }},
+		{"reforged rune", "/runesReforged.json", func(c *Client) error {
+			_, err := c.GetReforgedRune(8005)
+			return err
+		}},
+		{"summoner spells", "/summoner.json", func(c *Client) error { _, err := c.GetSummonerSpells(); return err }},
+		{"summoner spell by key", "/summoner.json", func(c *Client) error {
+			_, err := c.GetSummonerSpellByKey("4")
+			return err
+		}},
+		{"maps", "/map.json", func(c *Client) error { _, err := c.GetMaps(); return err }},
+		{"map", "/map.json", func(c *Client) error { _, err := c.GetMap(11); return err }},
+		{"challenges", "/challenges.json", func(c *Client) error { _, err := c.GetChallenges(); return err }},
+		{"tft champions", "/tft-champion.json", func(c *Client) error { _, err := c.GetTFTChampions(); return err }},
+		{"tft items", "/tft-item.json", func(c *Client) error { _, err := c.GetTFTItems(); return err }},
+		{"tft traits", "/tft-trait.json", func(c *Client) error { _, err := c.GetTFTTraits(); return err }},
+		{"tft augments", "/tft-augments.json", func(c *Client) error { _, err := c.GetTFTAugments(); return err }},
+		{"tft queues", "/tft-queues.json", func(c *Client) error { _, err := c.GetTFTQueues(); return err }},
+		{"tft regalia", "/tft-regalia.json", func(c *Client) error { _, err := c.GetTFTRegalia(); return err }},
+		{"tft arenas", "/tft-arena.json", func(c *Client) error { _, err := c.GetTFTArenas(); return err }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int32
+			responder := endpointResponseDoer(responses)
+			doer := &mock.Doer{
+				Custom: func(r *http.Request) (*http.Response, error) {
+					if strings.HasSuffix(r.URL.Path, tt.endpoint) {
+						atomic.AddInt32(&requests, 1)
+					}
+					return responder.Do(r)
+				},
+			}
+			// the legacy runes and masteries are only available for older versions
+			c := NewClient(doer, api.RegionEuropeWest, log.StandardLogger(),
+				WithLegacyRuneVersion(latestRuneAndMasteryVersion))
+			require.Nil(t, tt.get(c))
+			require.Nil(t, tt.get(c))
+			assert.Equal(t, int32(1), atomic.LoadInt32(&requests), "cached")
+			c.ClearCaches()
+			require.Nil(t, tt.get(c))
+			assert.Equal(t, int32(2), atomic.LoadInt32(&requests), "after ClearCaches")
+			require.Nil(t, c.SetVersion("9.11.1"))
+			require.Nil(t, tt.get(c))
+			assert.Equal(t, int32(3), atomic.LoadInt32(&requests), "after SetVersion")
+			require.Nil(t, c.SetLanguage(LanguageCodeGermany))
+			require.Nil(t, tt.get(c))
+			assert.Equal(t, int32(4), atomic.LoadInt32(&requests), "after SetLanguage")
+		})
+	}
+}
+
+// TestClient_ClearCaches_allFields asserts that ClearCaches empties every slice and map of the client which holds
+// cached data, so caches added in the future can not be forgotten
+func TestClient_ClearCaches_allFields(t *testing.T) {
+	t.Parallel()
+	c := newClient(&mock.Doer{}, log.StandardLogger(), WithHeaders(map[string]string{"User-Agent": "golio"}))
+	// fill every slice and map with one element
+	value := reflect.ValueOf(c).Elem()
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Field(i)
+		field = reflect.NewAt(field.Type(), unsafe.Pointer(field.UnsafeAddr())).Elem()
+		switch field.Kind() {
+		case reflect.Slice:
+			field.Set(reflect.MakeSlice(field.Type(), 1, 1))
+		case reflect.Map:
+			if field.IsNil() {
+				field.Set(reflect.MakeMap(field.Type()))
+			}
+			field.SetMapIndex(reflect.Zero(field.Type().Key()), reflect.Zero(field.Type().Elem()))
+		}
+	}
+	c.ClearCaches()
+	// configuration which is not cached data
+	ignored := map[string]bool{"headers": true, "statGoldValues": true}
+	for i := 0; i < value.NumField(); i++ {
+		field, name := value.Field(i), value.Type().Field(i).Name
+		if ignored[name] || (field.Kind() != reflect.Slice && field.Kind() != reflect.Map) {
+			continue
+		}
+		assert.Equal(t, 0, field.Len(), "%s is not cleared", name)
+	}
+}
+
+func TestClient_EachChampion(t *testing.T) {
+	t.Parallel()
+	doer := dataDragonResponseDoer(map[string]ChampionData{
+		"Ahri":   {ID: "Ahri", Name: "Ahri"},
+		"Aatrox": {ID: "Aatrox", Name: "Aatrox"},
+		"Zed":    {ID: "Zed", Name: "Zed"},
+	})
+	errStop := errors.New("stop")
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		stopAt  string
+		want    []string
+		wantErr error
+	}{
+		{
+			name: "all champions",
+			ctx:  context.Background(),
+			want: []string{"Aatrox", "Ahri", "Zed"},
+		},
+		{
+			name:    "stop on error",
+			ctx:     context.Background(),
+			stopAt:  "Ahri",
+			want:    []string{"Aatrox", "Ahri"},
+			wantErr: errStop,
+		},
+		{
+			name:    "cancelled",
+			ctx:     cancelled,
+			want:    []string{},
+			wantErr: context.Canceled,
+		},
+	}
+	c := NewClient(doer, api.RegionEuropeWest, log.StandardLogger())
+	require.Nil(t, c.EachChampion(context.Background(), func(ChampionData) error { return nil }))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []string{}
+			err := c.EachChampion(tt.ctx, func(champion ChampionData) error {
+				got = append(got, champion.ID)
+				if champion.ID == tt.stopAt {
+					return errStop
+				}
+				return nil
+			})
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_GetChampionByID(t *testing.T) {
+	type test struct {
+		name    string
+		doer    internal.Doer
+		id      string
+		want    ChampionDataExtended
+		wantErr error
+	}
+	tests := []test{
+		{
+			name: "get response",
+			doer: dataDragonResponseDoer(map[string]ChampionData{
+				"champion": {Name: "champion", Key: "1"},
+			}),
+			id:   "1",
+			want: ChampionDataExtended{ChampionData: ChampionData{Name: "champion", Key: "1"}},
+		},
+		{
+			name: "match key not id",
+			doer: dataDragonResponseDoer(map[string]ChampionData{
+				"champion": {Name: "champion", ID: "1", Key: "2"},
+			}),
+			id:      "1",
+			wantErr: fmt.Errorf("no champion with id 1: %w", api.ErrNotFound),
+		},
+		{
+			name:    "not found",
+			doer:    dataDragonResponseDoer(map[string]ChampionData{}),
+			id:      "1",
+			wantErr: fmt.Errorf("no champion with id 1: %w", api.ErrNotFound),
+		},
+		{
+			name: "unknown error",
+			doer: mock.NewStatusMockDoer(999),
+			wantErr: api.Error{
+				Message:    "unknown error reason",
+				StatusCode: 999,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := NewClient(test.doer, api.RegionEuropeWest, log.StandardLogger())
+			got, err := client.GetChampionByID(test.id)
+			assert.Equal(t, test.wantErr, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestClient_GetChampionByIntID(t *testing.T) {
+	t.Parallel()
+	doer := endpointResponseDoer(map[string]interface{}{
+		"/champion.json": dataDragonResponse{Data: map[string]ChampionData{
+			"Aatrox": {ID: "Aatrox", Key: "266", Name: "Aatrox"},
+		}},
+		"/champion/Aatrox.json": dataDragonResponse{Data: map[string]ChampionDataExtended{
+			"Aatrox": {ChampionData: ChampionData{ID: "Aatrox", Key: "266", Name: "Aatrox"}},
+		}},
+	})
+	client := NewClient(doer, api.RegionEuropeWest, log.StandardLogger())
+	got, err := client.GetChampionByIntID(266)
+	require.Nil(t, err)
+	assert.Equal(t, "Aatrox", got.Name)
+	_, err = client.GetChampionByIntID(1)
+	assert.EqualError(t, err, "no champion with id 1: not found")
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestClient_languageFallback(t *testing.T) {
+	t.Parallel()
+	var fallbackRequests int32
+	responder := endpointResponseDoer(map[string]interface{}{
+		"/de_DE/champion.json": dataDragonResponse{Data: map[string]ChampionData{
+			"Aatrox": {ID: "Aatrox", Name: "Aatrox", Title: "die Klinge der Düsteren"},
+			"Ahri":   {ID: "Ahri", Name: "Ahri", Title: "die neunschwänzige Füchsin", Blurb: "Ahri"},
+		}},
+		"/en_US/champion.json": dataDragonResponse{Data: map[string]ChampionData{
+			"Aatrox": {ID: "Aatrox", Name: "Aatrox", Title: "the Darkin Blade", Blurb: "Once honored defenders"},
+		}},
+		"/de_DE/champion/Ahri.json": dataDragonResponse{Data: map[string]ChampionDataExtended{
+			"Ahri": {ChampionData: ChampionData{ID: "Ahri", Name: "Ahri", Title: "die neunschwänzige Füchsin"}},
+		}},
+		"/en_US/champion/Ahri.json": dataDragonResponse{Data: map[string]ChampionDataExtended{
+			"Ahri": {ChampionData: ChampionData{ID: "Ahri", Blurb: "Innately connected"}, Lore: "lore"},
+		}},
+		"/de_DE/item.json": dataDragonResponse{Data: map[string]Item{
+			"1001": {Name: "Stiefel", Description: "Lauftempo"},
+			"3006": {},
+		}},
+		"/en_US/item.json": dataDragonResponse{Data: map[string]Item{
+			"3006": {Name: "Berserker's Greaves", Description: "Attack Speed"},
+		}},
+		"/de_DE/summoner.json": dataDragonResponse{Data: map[string]SummonerSpell{
+			"SummonerFlash": {ID: "SummonerFlash", Name: "Blitz"},
+		}},
+	})
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			if strings.Contains(r.URL.Path, "/en_US/") {
+				atomic.AddInt32(&fallbackRequests, 1)
+			}
+			return responder.Do(r)
+		},
+	}
+	c := newClient(doer, log.StandardLogger(), WithLanguageFallback(LanguageCodeUnitedStates))
+	c.version, c.language = "13.24.1", LanguageCodeGermany
+
+	champions, err := c.GetChampions()
+	require.Nil(t, err)
+	require.Len(t, champions, 2)
+	for _, champion := range champions {
+		if champion.ID == "Aatrox" {
+			assert.Equal(t, "die Klinge der Düsteren", champion.Title)
+			assert.Equal(t, "Once honored defenders", champion.Blurb)
+		}
+	}
+	ahri, err := c.GetChampion("Ahri")
+	require.Nil(t, err)
+	assert.Equal(t, "die neunschwänzige Füchsin", ahri.Title)
+	assert.Equal(t, "Innately connected", ahri.Blurb)
+	assert.Equal(t, "lore", ahri.Lore)
+	item, err := c.GetItem("3006")
+	require.Nil(t, err)
+	assert.Equal(t, "Berserker's Greaves", item.Name)
+	item, err = c.GetItem("1001")
+	require.Nil(t, err)
+	assert.Equal(t, "Stiefel", item.Name)
+	_, err = c.GetSummonerSpell("SummonerFlash")
+	require.Nil(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&fallbackRequests))
+}
+
+func TestClient_DiffChampions(t *testing.T) {
+	t.Parallel()
+	responses := map[string]interface{}{
+		"/13.23.1/data/en_US/champion.json": dataDragonResponse{Data: map[string]ChampionData{
+			"Aatrox": {Version: "13.23.1", ID: "Aatrox", Title: "the Darkin Blade"},
+			"Ahri":   {Version: "13.23.1", ID: "Ahri", Stats: ChampionDataStats{AttackDamage: 53}},
+			"Zed":    {Version: "13.23.1", ID: "Zed"},
+		}},
+		"/13.24.1/data/en_US/champion.json": dataDragonResponse{Data: map[string]ChampionData{
+			"Aatrox": {Version: "13.24.1", ID: "Aatrox", Title: "the Darkin Blade"},
+			"Ahri":   {Version: "13.24.1", ID: "Ahri", Stats: ChampionDataStats{AttackDamage: 55}},
+			"Hwei":   {Version: "13.24.1", ID: "Hwei"},
+		}},
+	}
+	tests := []struct {
+		name        string
+		v1, v2      string
+		wantAdded   []string
+		wantRemoved []string
+		wantChanged []string
+		wantErr     bool
+	}{
+		{
+			name:        "diff",
+			v1:          "13.23.1",
+			v2:          "13.24.1",
+			wantAdded:   []string{"Hwei"},
+			wantRemoved: []string{"Zed"},
+			wantChanged: []string{"Ahri"},
+		},
+		{name: "same version", v1: "13.24.1", v2: "13.24.1"},
+		{name: "invalid version", v1: "latest", v2: "13.24.1", wantErr: true},
+		{name: "unknown version", v1: "13.23.1", v2: "13.22.1", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newClient(endpointResponseDoer(responses), log.StandardLogger())
+			c.version, c.language = "9.10.1", LanguageCodeUnitedStates
+			added, removed, changed, err := c.DiffChampions(tt.v1, tt.v2)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.wantAdded, added)
+			assert.Equal(t, tt.wantRemoved, removed)
+			assert.Equal(t, tt.wantChanged, changed)
+			assert.Equal(t, "9.10.1", c.GetVersion())
+			assert.Len(t, c.championsByID, 0)
+		})
+	}
+}
+
+func TestClient_ItemsAddedInVersion(t *testing.T) {
+	t.Parallel()
+	responses := map[string]interface{}{
+		"/api/versions.json": []string{"13.24.1", "13.23.1", "13.22.1", "lolpatch_3.7"},
+		"/13.22.1/data/en_US/item.json": dataDragonResponse{Data: map[string]Item{
+			"1001": {Name: "Boots"},
+		}},
+		"/13.23.1/data/en_US/item.json": json.RawMessage(
+			`{"data":{"1001":{"name":"Boots"},"3006":{"name":"Berserker's Greaves"}}}`,
+		),
+		"/13.24.1/data/en_US/item.json": json.RawMessage(`{"data":{"1001":{"name":"Boots"},` +
+			`"3006":{"name":"Berserker's Greaves"},"3031":{"name":"Infinity Edge"},` +
+			`"3009":{"name":"Boots of Swiftness","inStore":false}}}`),
+	}
+	tests := []struct {
+		name    string
+		version string
+		want    []Item
+		wantErr bool
+	}{
+		{
+			name:    "latest version",
+			version: "13.24.1",
+			want: []Item{
+				{ID: "3009", Name: "Boots of Swiftness"},
+				{ID: "3031", Name: "Infinity Edge", InStore: true},
+			},
+		},
+		{
+			name:    "older version",
+			version: "13.23.1",
+			want:    []Item{{ID: "3006", Name: "Berserker's Greaves", InStore: true}},
+		},
+		{name: "first version", version: "13.22.1", wantErr: true},
+		{name: "invalid version", version: "lolpatch_3.7", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newClient(endpointResponseDoer(responses), log.StandardLogger())
+			c.version, c.language = "13.24.1", LanguageCodeUnitedStates
+			got, err := c.ItemsAddedInVersion(tt.version)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.want, got)
+			assert.Len(t, c.items, 0)
+		})
+	}
+}
+
+func TestClient_GetChampionLoreAndBlurb(t *testing.T) {
+	t.Parallel()
+	var requested []string
+	responder := endpointResponseDoer(map[string]interface{}{
+		"/champion.json": dataDragonResponse{Data: map[string]ChampionData{
+			"Aatrox": {ID: "Aatrox", Key: "266", Blurb: "Once honored defenders"},
+		}},
+		"/champion/Aatrox.json": dataDragonResponse{Data: map[string]ChampionDataExtended{
+			"Aatrox": {ChampionData: ChampionData{ID: "Aatrox", Key: "266"}, Lore: "Once honored defenders of Shurima"},
+		}},
+	})
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			requested = append(requested, path.Base(r.URL.Path))
+			return responder.Do(r)
+		},
+	}
+	c := newClient(doer, log.StandardLogger())
+	c.version, c.language = "13.24.1", LanguageCodeUnitedStates
+	blurb, err := c.GetChampionBlurb("aatrox")
+	require.Nil(t, err)
+	assert.Equal(t, "Once honored defenders", blurb)
+	assert.Equal(t, []string{"champion.json"}, requested)
+	lore, err := c.GetChampionLore("Aatrox")
+	require.Nil(t, err)
+	assert.Equal(t, "Once honored defenders of Shurima", lore)
+	_, err = c.GetChampionBlurb("Zed")
+	assert.Equal(t, api.ErrNotFound, err)
+	_, err = c.GetChampionLore("Zed")
+	assert.Equal(t, api.ErrNotFound, err)
+}
+
+func TestClient_GetChampionByLocalizedName(t *testing.T) {
+	t.Parallel()
+	wukong := ChampionDataExtended{
+		ChampionData: ChampionData{ID: "MonkeyKing", Key: "62", Name: "오공"},
+		Lore:         "lore",
+	}
+	doer := endpointResponseDoer(map[string]interface{}{
+		"/champion.json": dataDragonResponse{Data: map[string]ChampionData{
+			"MonkeyKing": wukong.ChampionData,
+			"Aatrox":     {ID: "Aatrox", Key: "266", Name: "아트록스"},
+		}},
+		"/champion/MonkeyKing.json": dataDragonResponse{Data: map[string]ChampionDataExtended{"MonkeyKing": wukong}},
+	})
+	tests := []struct {
+		name    string
+		query   string
+		want    ChampionDataExtended
+		wantErr error
+	}{
+		{
+			name:  "get response",
+			query: "오공",
+			want:  wukong,
+		},
+		{
+			name:    "id is not a localized name",
+			query:   "MonkeyKing",
+			wantErr: fmt.Errorf("no champion with localized name %q: %w", "MonkeyKing", api.ErrNotFound),
+		},
+		{
+			name:    "extended data not found",
+			query:   "아트록스",
+			wantErr: api.ErrNotFound,
+		},
+	}
+	c := NewClient(doer, api.RegionKorea, log.StandardLogger())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.GetChampionByLocalizedName(tt.query)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, api.ErrNotFound))
+			}
+		})
+	}
+}
+
+func TestClient_GetProfileIcon(t *testing.T) {
+	type test struct {
+		name    string
+		doer    internal.Doer
+		id      int
+		want    ProfileIcon
+		wantErr error
+	}
+	tests := []test{
+		{
+			name: "get response",
+			doer: dataDragonResponseDoer(map[string]ProfileIcon{
+				"icon": {ID: 1},
+			}),
+			id:   1,
+			want: ProfileIcon{ID: 1},
+		},
+		{
+			name: "get response from multiple icons",
+			doer: dataDragonResponseDoer(map[string]ProfileIcon{
+				"1":   {ID: 1},
+				"588": {ID: 588, Image: ImageData{Full: "588.png"}},
+			}),
+			id:   588,
+			want: ProfileIcon{ID: 588, Image: ImageData{Full: "588.png"}},
+		},
+		{
+			name:    "not found",
+			doer:    dataDragonResponseDoer(map[string]ProfileIcon{}),
+			wantErr: api.ErrNotFound,
+		},
+		{
+			name: "unknown id",
+			doer: dataDragonResponseDoer(map[string]ProfileIcon{
+				"1": {ID: 1},
+			}),
+			id:      2,
+			wantErr: api.ErrNotFound,
+		},
+		{
+			name: "unknown error",
+			doer: mock.NewStatusMockDoer(999),
+			wantErr: api.Error{
+				Message:    "unknown error reason",
+				StatusCode: 999,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := NewClient(test.doer, api.RegionEuropeWest, log.StandardLogger())
+			got, err := client.GetProfileIcon(test.id)
+			assert.Equal(t, test.wantErr, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestClient_GetItem(t *testing.T) {
+	type test struct {
+		name    string
+		doer    internal.Doer
+		id      string
+		want    Item
+		wantErr error
+	}
+	tests := []test{
+		{
+			name: "get response",
+			doer: dataDragonResponseDoer(map[string]Item{
+				"id": {},
+			}),
+			id:   "id",
+			want: Item{ID: "id"},
+		},
+		{
+			name:    "not found",
+			doer:    dataDragonResponseDoer(map[string]Item{"id": {}}),
+			id:      "1004",
+			wantErr: fmt.Errorf("no item with id 1004: %w", api.ErrNotFound),
+		},
+		{
+			name: "unknown error",
+			doer: mock.NewStatusMockDoer(999),
+			wantErr: api.Error{
+				Message:    "unknown error reason",
+				StatusCode: 999,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := NewClient(test.doer, api.RegionEuropeWest, log.StandardLogger())
+			got, err := client.GetItem(test.id)
+			assert.Equal(t, test.wantErr, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestClient_GetItem_notFound(t *testing.T) {
+	t.Parallel()
+	c := NewClient(dataDragonResponseDoer(map[string]Item{"1001": {}}), api.RegionEuropeWest, log.StandardLogger())
+	_, err := c.GetItem("1004")
+	assert.EqualError(t, err, "no item with id 1004: not found")
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestClient_GetItemBuildTree(t *testing.T) {
+	t.Parallel()
+	longSword := Item{ID: "1036", Into: []string{"3133"}}
+	caulfields := Item{ID: "3133", From: []string{"1036", "1036"}, Into: []string{"3071"}}
+	kindlegem := Item{ID: "3067", Into: []string{"3071"}}
+	blackCleaver := Item{ID: "3071", From: []string{"3133", "3067", "9999"}}
+	tests := []struct {
+		name    string
+		doer    internal.Doer
+		id      string
+		want    *ItemTree
+		wantErr error
+	}{
+		{
+			name: "get response",
+			doer: dataDragonResponseDoer(map[string]Item{
+				"1036": longSword,
+				"3133": caulfields,
+				"3067": kindlegem,
+				"3071": blackCleaver,
+			}),
+			id: "3071",
+			want: &ItemTree{
+				Item: blackCleaver,
+				Components: []*ItemTree{
+					{
+						Item:       caulfields,
+						Components: []*ItemTree{{Item: longSword}, {Item: longSword}},
+					},
+					{Item: kindlegem},
+					{Item: Item{ID: "9999"}},
+				},
+			},
+		},
+		{
+			name: "cycle",
+			doer: dataDragonResponseDoer(map[string]Item{
+				"1": {From: []string{"2"}},
+				"2": {From: []string{"1"}},
+			}),
+			id:      "1",
+			wantErr: fmt.Errorf("cycle in build path of item 1"),
+		},
+		{
+			name: "not found",
+			doer: dataDragonResponseDoer(map[string]Item{
+				"1036": longSword,
+			}),
+			id:      "3071",
+			wantErr: api.ErrNotFound,
+		},
+		{
+			name:    "known error",
+			doer:    mock.NewStatusMockDoer(http.StatusForbidden),
+			wantErr: api.ErrForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(tt.doer, api.RegionEuropeWest, log.StandardLogger())
+			got, err := c.GetItemBuildTree(tt.id)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_GetMastery(t *testing.T) {
+	type test struct {
+		name    string
+		doer    internal.Doer
+		id      int
+		want    Mastery
+		wantErr error
+	}
+	tests := []test{
 		{
 			name: "get response",
-			doer: dataDragonResponseDoer(map[string]ProfileIcon{
+			doer: dataDragonResponseDoer(map[string]Mastery{
 				"icon": {ID: 1},
 			}),
 			id:   1,
-			want: ProfileIcon{ID: 1},
+			want: Mastery{ID: 1},
 		},
 		{
 			name:    "not found",
-			doer:    dataDragonResponseDoer(map[string]ProfileIcon{}),
+			doer:    dataDragonResponseDoer(map[string]Mastery{}),
 			wantErr: api.ErrNotFound,
 		},
 		{
@@ -413,15 +3246,16 @@ func TestClient_GetProfileIcon(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			client := NewClient(test.doer, api.RegionEuropeWest, log.StandardLogger())
-			got, err := client.GetProfileIcon(test.id)
+			client := NewClient(test.doer, api.RegionEuropeWest, log.StandardLogger(),
+				WithLegacyRuneVersion(latestRuneAndMasteryVersion))
+			got, err := client.GetMastery(test.id)
 			assert.Equal(t, test.wantErr, err)
 			assert.Equal(t, test.want, got)
 		})
 	}
 }
 
-func TestClient_GetItem(t *testing.T) {
+func TestClient_GetRune(t *testing.T) {
 	type test struct {
 		name    string
 		doer    internal.Doer
@@ -452,36 +3286,344 @@ func TestClient_GetItem(t *testing.T) {
 			},
 		},
 	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := NewClient(test.doer, api.RegionEuropeWest, log.StandardLogger(),
+				WithLegacyRuneVersion(latestRuneAndMasteryVersion))
+			got, err := client.GetRune(test.id)
+			assert.Equal(t, test.wantErr, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestClient_GetReforgedRune(t *testing.T) {
+	type test struct {
+		name    string
+		doer    internal.Doer
+		id      int
+		want    RuneReforged
+		wantErr error
+	}
+	tests := []test{
+		{
+			name: "get response",
+			doer: mock.NewJSONMockDoer([]RuneReforgedPath{
+				{ID: 8000, Slots: []RuneReforgedSlot{{Runes: []RuneReforged{{ID: 8005, Name: "Press the Attack"}}}}},
+			}, 200),
+			id:   8005,
+			want: RuneReforged{ID: 8005, Name: "Press the Attack"},
+		},
+		{
+			name: "not found",
+			doer: mock.NewJSONMockDoer([]RuneReforgedPath{
+				{ID: 8000, Slots: []RuneReforgedSlot{{Runes: []RuneReforged{{ID: 8005}}}}},
+			}, 200),
+			id:      8000,
+			wantErr: api.ErrNotFound,
+		},
+		{
+			name: "unknown error",
+			doer: mock.NewStatusMockDoer(999),
+			wantErr: api.Error{
+				Message:    "unknown error reason",
+				StatusCode: 999,
+			},
+		},
+	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			client := NewClient(test.doer, api.RegionEuropeWest, log.StandardLogger())
-			got, err := client.GetItem(test.id)
+			got, err := client.GetReforgedRune(test.id)
+			assert.Equal(t, test.wantErr, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestClient_GetSummonerSpell(t *testing.T) {
+	type test struct {
+		name    string
+		doer    internal.Doer
+		id      string
+		want    SummonerSpell
+		wantErr error
+	}
+	tests := []test{
+		{
+			name: "get response",
+			doer: dataDragonResponseDoer(map[string]SummonerSpell{
+				"id": {ID: "id"},
+			}),
+			id:   "id",
+			want: SummonerSpell{ID: "id"},
+		},
+		{
+			name: "not found",
+			doer: dataDragonResponseDoer(map[string]SummonerSpell{
+				"id": {ID: "id"},
+			}),
+			id:      "unknown",
+			wantErr: fmt.Errorf("no summoner spell with id unknown: %w", api.ErrNotFound),
+		},
+		{
+			name: "unknown error",
+			doer: mock.NewStatusMockDoer(999),
+			wantErr: api.Error{
+				Message:    "unknown error reason",
+				StatusCode: 999,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := NewClient(test.doer, api.RegionEuropeWest, log.StandardLogger())
+			got, err := client.GetSummonerSpell(test.id)
+			assert.Equal(t, test.wantErr, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestClient_GetSummonerSpellByID(t *testing.T) {
+	type test struct {
+		name    string
+		doer    internal.Doer
+		id      string
+		want    SummonerSpell
+		wantErr error
+	}
+	tests := []test{
+		{
+			name: "get response",
+			doer: dataDragonResponseDoer(map[string]SummonerSpell{
+				"SummonerFlash": {ID: "SummonerFlash", Key: "4"},
+			}),
+			id:   "SummonerFlash",
+			want: SummonerSpell{ID: "SummonerFlash", Key: "4"},
+		},
+		{
+			name: "not found",
+			doer: dataDragonResponseDoer(map[string]SummonerSpell{
+				"SummonerFlash": {ID: "SummonerFlash", Key: "4"},
+			}),
+			id:      "4",
+			wantErr: fmt.Errorf("no summoner spell with id 4: %w", api.ErrNotFound),
+		},
+		{
+			name: "unknown error",
+			doer: mock.NewStatusMockDoer(999),
+			wantErr: api.Error{
+				Message:    "unknown error reason",
+				StatusCode: 999,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := NewClient(test.doer, api.RegionEuropeWest, log.StandardLogger())
+			got, err := client.GetSummonerSpellByID(test.id)
+			assert.Equal(t, test.wantErr, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestClient_GetSummonerSpellByKey(t *testing.T) {
+	type test struct {
+		name    string
+		doer    internal.Doer
+		key     string
+		want    SummonerSpell
+		wantErr error
+	}
+	tests := []test{
+		{
+			name: "get response",
+			doer: dataDragonResponseDoer(map[string]SummonerSpell{
+				"SummonerFlash": {ID: "SummonerFlash", Key: "4"},
+			}),
+			key:  "4",
+			want: SummonerSpell{ID: "SummonerFlash", Key: "4"},
+		},
+		{
+			name: "not found",
+			doer: dataDragonResponseDoer(map[string]SummonerSpell{
+				"SummonerFlash": {ID: "SummonerFlash", Key: "4"},
+			}),
+			key:     "SummonerFlash",
+			wantErr: fmt.Errorf("no summoner spell with key SummonerFlash: %w", api.ErrNotFound),
+		},
+		{
+			name: "unknown error",
+			doer: mock.NewStatusMockDoer(999),
+			wantErr: api.Error{
+				Message:    "unknown error reason",
+				StatusCode: 999,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := NewClient(test.doer, api.RegionEuropeWest, log.StandardLogger())
+			got, err := client.GetSummonerSpellByKey(test.key)
 			assert.Equal(t, test.wantErr, err)
 			assert.Equal(t, test.want, got)
 		})
 	}
 }
 
-func TestClient_GetMastery(t *testing.T) {
+func TestClient_GetSummonerSpell_notFound(t *testing.T) {
+	t.Parallel()
+	c := NewClient(dataDragonResponseDoer(map[string]SummonerSpell{
+		"SummonerFlash": {ID: "SummonerFlash", Key: "4"},
+	}), api.RegionEuropeWest, log.StandardLogger())
+	_, err := c.GetSummonerSpellByID("SummonerTeleport")
+	assert.EqualError(t, err, "no summoner spell with id SummonerTeleport: not found")
+	assert.True(t, errors.Is(err, ErrNotFound))
+	_, err = c.GetSummonerSpellByKey("12")
+	assert.EqualError(t, err, "no summoner spell with key 12: not found")
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestClient_GetMaps(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		doer    internal.Doer
+		want    []GameMap
+		wantErr error
+	}{
+		{
+			name: "get response",
+			doer: dataDragonResponseDoer(map[string]GameMap{
+				"11": {ID: 11, Name: "Summoner's Rift"},
+			}),
+			want: []GameMap{{ID: 11, Name: "Summoner's Rift"}},
+		},
+		{
+			name:    "known error",
+			doer:    mock.NewStatusMockDoer(http.StatusForbidden),
+			wantErr: api.ErrForbidden,
+		},
+		{
+			name: "unknown error",
+			doer: mock.NewStatusMockDoer(999),
+			wantErr: api.Error{
+				Message:    "unknown error reason",
+				StatusCode: 999,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(tt.doer, api.RegionEuropeWest, log.StandardLogger())
+			got, err := c.GetMaps()
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.want, got)
+				got, err := c.GetMaps()
+				assert.Nil(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestClient_GetChallenges(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		doer    internal.Doer
+		want    []Challenge
+		wantErr error
+	}{
+		{
+			name: "get response",
+			doer: &mock.Doer{
+				Custom: func(r *http.Request) (*http.Response, error) {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body: &mock.ResponseBody{Content: []byte(`[{
+							"id": 101000,
+							"name": "ARAM Authority",
+							"description": "Earn points from challenges in the ARAM group",
+							"shortDescription": "Earn points from ARAM challenges",
+							"hasLeaderboard": false,
+							"levelToIconPath": {"IRON": "/challenges/101000-IRON.png"},
+							"thresholds": {
+								"IRON": {"value": 1},
+								"GOLD": {
+									"value": 50,
+									"rewards": [{"category": "TITLE", "quantity": 1, "title": "Fearless"}]
+								}
+							}
+						}]`)},
+					}, nil
+				},
+			},
+			want: []Challenge{
+				{
+					ID:               101000,
+					Name:             "ARAM Authority",
+					Description:      "Earn points from challenges in the ARAM group",
+					ShortDescription: "Earn points from ARAM challenges",
+					LevelToIconPath:  map[string]string{"IRON": "/challenges/101000-IRON.png"},
+					Thresholds: map[string]ChallengeThreshold{
+						"IRON": {Value: 1},
+						"GOLD": {
+							Value:   50,
+							Rewards: []ChallengeReward{{Category: "TITLE", Quantity: 1, Title: "Fearless"}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "known error",
+			doer:    mock.NewStatusMockDoer(http.StatusForbidden),
+			wantErr: api.ErrForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newClient(tt.doer, log.StandardLogger())
+			got, err := c.GetChallenges()
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.want, got)
+				got, err := c.GetChallenges()
+				assert.Nil(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestClient_GetMap(t *testing.T) {
 	type test struct {
 		name    string
 		doer    internal.Doer
 		id      int
-		want    Mastery
+		want    GameMap
 		wantErr error
 	}
 	tests := []test{
 		{
 			name: "get response",
-			doer: dataDragonResponseDoer(map[string]Mastery{
-				"icon": {ID: 1},
+			doer: dataDragonResponseDoer(map[string]GameMap{
+				"11": {ID: 11, Name: "Summoner's Rift"},
+				"12": {ID: 12, Name: "Howling Abyss"},
 			}),
-			id:   1,
-			want: Mastery{ID: 1},
+			id:   12,
+			want: GameMap{ID: 12, Name: "Howling Abyss"},
 		},
 		{
-			name:    "not found",
-			doer:    dataDragonResponseDoer(map[string]Mastery{}),
+			name: "not found",
+			doer: dataDragonResponseDoer(map[string]GameMap{
+				"11": {ID: 11, Name: "Summoner's Rift"},
+			}),
+			id:      10,
 			wantErr: api.ErrNotFound,
 		},
 		{
@@ -496,91 +3638,149 @@ func TestClient_GetMastery(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			client := NewClient(test.doer, api.RegionEuropeWest, log.StandardLogger())
-			got, err := client.GetMastery(test.id)
+			got, err := client.GetMap(test.id)
 			assert.Equal(t, test.wantErr, err)
 			assert.Equal(t, test.want, got)
 		})
 	}
 }
 
-func TestClient_GetRune(t *testing.T) {
-	type test struct {
-		name    string
-		doer    internal.Doer
-		id      string
-		want    Item
-		wantErr error
-	}
-	tests := []test{
+func TestErrNotFound(t *testing.T) {
+	t.Parallel()
+	c := NewClient(endpointResponseDoer(map[string]interface{}{
+		"/champion.json":      dataDragonResponse{Data: map[string]ChampionData{}},
+		"/item.json":          dataDragonResponse{Data: map[string]Item{"1001": {}}},
+		"/summoner.json":      dataDragonResponse{Data: map[string]SummonerSpell{"SummonerFlash": {}}},
+		"/profileicon.json":   dataDragonResponse{Data: map[string]ProfileIcon{"1": {ID: 1}}},
+		"/runesReforged.json": []RuneReforgedPath{{}},
+		"/map.json":           dataDragonResponse{Data: map[string]GameMap{"11": {ID: 11}}},
+	}), api.RegionEuropeWest, log.StandardLogger())
+	tests := []struct {
+		name   string
+		lookup func() error
+	}{
 		{
-			name: "get response",
-			doer: dataDragonResponseDoer(map[string]Item{
-				"id": {},
-			}),
-			id:   "id",
-			want: Item{ID: "id"},
+			name: "champion",
+			lookup: func() error {
+				_, err := c.GetChampion("champion")
+				return err
+			},
 		},
 		{
-			name:    "not found",
-			doer:    dataDragonResponseDoer(map[string]Item{}),
-			wantErr: api.ErrNotFound,
+			name: "champion by id",
+			lookup: func() error {
+				_, err := c.GetChampionByID("1")
+				return err
+			},
 		},
 		{
-			name: "unknown error",
-			doer: mock.NewStatusMockDoer(999),
-			wantErr: api.Error{
-				Message:    "unknown error reason",
-				StatusCode: 999,
+			name: "item",
+			lookup: func() error {
+				_, err := c.GetItem("0")
+				return err
+			},
+		},
+		{
+			name: "summoner spell",
+			lookup: func() error {
+				_, err := c.GetSummonerSpellByKey("0")
+				return err
+			},
+		},
+		{
+			name: "profile icon",
+			lookup: func() error {
+				_, err := c.GetProfileIcon(0)
+				return err
+			},
+		},
+		{
+			name: "reforged rune",
+			lookup: func() error {
+				_, err := c.GetReforgedRune(0)
+				return err
+			},
+		},
+		{
+			name: "map",
+			lookup: func() error {
+				_, err := c.GetMap(0)
+				return err
 			},
 		},
 	}
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
-			client := NewClient(test.doer, api.RegionEuropeWest, log.StandardLogger())
-			got, err := client.GetRune(test.id)
-			assert.Equal(t, test.wantErr, err)
-			assert.Equal(t, test.want, got)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.lookup()
+			assert.True(t, errors.Is(err, ErrNotFound))
+			assert.True(t, errors.Is(fmt.Errorf("wrapped: %w", err), ErrNotFound))
 		})
 	}
 }
 
-func TestClient_GetSummonerSpell(t *testing.T) {
-	type test struct {
-		name    string
-		doer    internal.Doer
-		id      string
-		want    SummonerSpell
-		wantErr error
-	}
-	tests := []test{
+func TestClient_ctx(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		call func(c *Client, ctx context.Context) error
+	}{
 		{
-			name: "get response",
-			doer: dataDragonResponseDoer(map[string]SummonerSpell{
-				"id": {ID: "id"},
-			}),
-			id:   "id",
-			want: SummonerSpell{ID: "id"},
+			name: "GetVersionsCtx",
+			call: func(c *Client, ctx context.Context) error {
+				_, err := c.GetVersionsCtx(ctx)
+				return err
+			},
 		},
 		{
-			name:    "not found",
-			doer:    dataDragonResponseDoer(map[string]SummonerSpell{}),
-			wantErr: api.ErrNotFound,
+			name: "GetChampionsCtx",
+			call: func(c *Client, ctx context.Context) error {
+				_, err := c.GetChampionsCtx(ctx)
+				return err
+			},
 		},
 		{
-			name: "unknown error",
-			doer: mock.NewStatusMockDoer(999),
-			wantErr: api.Error{
-				Message:    "unknown error reason",
-				StatusCode: 999,
+			name: "GetChampionCtx",
+			call: func(c *Client, ctx context.Context) error {
+				_, err := c.GetChampionCtx(ctx, "champion")
+				return err
+			},
+		},
+		{
+			name: "GetItemCtx",
+			call: func(c *Client, ctx context.Context) error {
+				_, err := c.GetItemCtx(ctx, "id")
+				return err
+			},
+		},
+		{
+			name: "GetReforgedRunesCtx",
+			call: func(c *Client, ctx context.Context) error {
+				_, err := c.GetReforgedRunesCtx(ctx)
+				return err
+			},
+		},
+		{
+			name: "GetSummonerSpellByKeyCtx",
+			call: func(c *Client, ctx context.Context) error {
+				_, err := c.GetSummonerSpellByKeyCtx(ctx, "4")
+				return err
 			},
 		},
 	}
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
-			client := NewClient(test.doer, api.RegionEuropeWest, log.StandardLogger())
-			got, err := client.GetSummonerSpell(test.id)
-			assert.Equal(t, test.wantErr, err)
-			assert.Equal(t, test.want, got)
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			if err := r.Context().Err(); err != nil {
+				return nil, err
+			}
+			return nil, fmt.Errorf("context was not passed")
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(doer, api.RegionEuropeWest, log.StandardLogger())
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			assert.Equal(t, context.Canceled, tt.call(c, ctx))
 		})
 	}
 }
@@ -615,7 +3815,7 @@ func TestClient_doRequest(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			c := NewClient(tt.doer, api.RegionEuropeWest, log.StandardLogger())
-			_, err := c.doRequest(tt.format, tt.endpoint)
+			_, err := c.doRequest(context.Background(), tt.format, tt.endpoint)
 			assert.Equal(t, err != nil, tt.wantErr)
 		})
 	}
@@ -639,11 +3839,21 @@ func TestClient_init(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name:    "no version",
+			doer:    mock.NewJSONMockDoer(map[string]string{"l": "en_US"}, http.StatusOK),
+			wantErr: true,
+		},
+		{
+			name:    "valid",
+			doer:    mock.NewJSONMockDoer(map[string]string{"v": "9.10.1", "l": "en_US"}, http.StatusOK),
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			c := NewClient(tt.doer, api.RegionOceania, log.StandardLogger())
-			if err := c.init(api.RegionOceania); (err != nil) != tt.wantErr {
+			if err := c.init(context.Background(), api.RegionOceania); (err != nil) != tt.wantErr {
 				t.Errorf("Client.init() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
@@ -666,12 +3876,186 @@ func TestClient_getInto(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			c := NewClient(mock.NewJSONMockDoer(0, 200), api.RegionOceania, log.StandardLogger())
-			err := c.getInto("endpoint", tt.target)
+			err := c.getInto(context.Background(), "endpoint", tt.target)
 			assert.Equal(t, tt.wantErr, err != nil)
 		})
 	}
 }
 
+func TestClient_url(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name              string
+		baseURL           string
+		legacyRuneVersion string
+		format            dataDragonURL
+		endpoint          string
+		want              string
+	}{
+		{
+			name:     "current version",
+			format:   dataDragonDataURLFormat,
+			endpoint: "/champion.json",
+			want:     "https://ddragon.leagueoflegends.com/cdn/9.10.1/data/en_US/champion.json",
+		},
+		{
+			name:              "pinned legacy runes",
+			legacyRuneVersion: "7.10.1",
+			format:            dataDragonDataURLFormat,
+			endpoint:          "/rune.json",
+			want:              "https://ddragon.leagueoflegends.com/cdn/7.10.1/data/en_US/rune.json",
+		},
+		{
+			name:              "pinned legacy masteries",
+			legacyRuneVersion: "7.10.1",
+			format:            dataDragonDataURLFormat,
+			endpoint:          "/mastery.json",
+			want:              "https://ddragon.leagueoflegends.com/cdn/7.10.1/data/en_US/mastery.json",
+		},
+		{
+			name:              "pinned legacy version not used for other endpoints",
+			legacyRuneVersion: "7.10.1",
+			format:            dataDragonDataURLFormat,
+			endpoint:          "/champion.json",
+			want:              "https://ddragon.leagueoflegends.com/cdn/9.10.1/data/en_US/champion.json",
+		},
+		{
+			name:     "runes reforged",
+			format:   dataDragonDataURLFormat,
+			endpoint: "/runesReforged.json",
+			want:     "https://ddragon.leagueoflegends.com/cdn/9.10.1/data/en_US/runesReforged.json",
+		},
+		{
+			name:     "image",
+			format:   dataDragonImageURLFormat,
+			endpoint: "/champion/Aatrox.png",
+			want:     "https://ddragon.leagueoflegends.com/cdn/9.10.1/img/champion/Aatrox.png",
+		},
+		{
+			name:     "base url",
+			baseURL:  "http://mirror.example.com/ddragon",
+			format:   dataDragonDataURLFormat,
+			endpoint: "/champion.json",
+			want:     "http://mirror.example.com/ddragon/cdn/9.10.1/data/en_US/champion.json",
+		},
+		{
+			name:     "base url without version",
+			baseURL:  "http://mirror.example.com",
+			format:   dataDragonBaseURL,
+			endpoint: "/api/versions.json",
+			want:     "http://mirror.example.com/api/versions.json",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				version:           "9.10.1",
+				language:          LanguageCodeUnitedStates,
+				baseURL:           tt.baseURL,
+				legacyRuneVersion: tt.legacyRuneVersion,
+			}
+			assert.Equal(t, tt.want, c.url(tt.format, tt.endpoint))
+		})
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		v1   string
+		v2   string
+		want int
+	}{
+		{
+			name: "equal",
+			v1:   "9.10.1",
+			v2:   "9.10.1",
+			want: 0,
+		},
+		{
+			name: "first lower",
+			v1:   "9.9.1",
+			v2:   "9.10.1",
+			want: -1,
+		},
+		{
+			name: "first greater",
+			v1:   "10.1.1",
+			v2:   "9.24.2",
+			want: 1,
+		},
+		{
+			name: "missing components equal",
+			v1:   "9.10",
+			v2:   "9.10.0",
+			want: 0,
+		},
+		{
+			name: "missing component lower",
+			v1:   "7.23",
+			v2:   "7.23.1",
+			want: -1,
+		},
+		{
+			name: "additional component greater",
+			v1:   "7.23.1.2",
+			v2:   "7.23.1",
+			want: 1,
+		},
+		{
+			name: "non-numeric components",
+			v1:   "lolpatch_7.20",
+			v2:   "lolpatch_7.19",
+			want: 1,
+		},
+		{
+			name: "non-numeric and numeric component",
+			v1:   "0.151.2",
+			v2:   "lolpatch_3.7",
+			want: -1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, CompareVersions(tt.v1, tt.v2))
+			assert.Equal(t, -tt.want, CompareVersions(tt.v2, tt.v1))
+		})
+	}
+}
+
+func TestClient_IsVersionAtLeast(t *testing.T) {
+	t.Parallel()
+	c := newClient(mock.NewStatusMockDoer(http.StatusNotFound), log.StandardLogger())
+	c.version = "9.10.1"
+	tests := []struct {
+		name    string
+		version string
+		want    bool
+	}{
+		{
+			name:    "equal",
+			version: "9.10.1",
+			want:    true,
+		},
+		{
+			name:    "lower",
+			version: "7.22",
+			want:    true,
+		},
+		{
+			name:    "greater",
+			version: "9.11",
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, c.IsVersionAtLeast(tt.version))
+		})
+	}
+}
+
 func Test_versionGreaterThan(t *testing.T) {
 	t.Parallel()
 	type args struct {
@@ -679,17 +4063,26 @@ func Test_versionGreaterThan(t *testing.T) {
 		v2 string
 	}
 	tests := []struct {
-		name string
-		args args
-		want bool
+		name    string
+		args    args
+		want    bool
+		wantErr bool
 	}{
+		{
+			name: "invalid first arg",
+			args: args{
+				v1: "a",
+				v2: "1",
+			},
+			wantErr: true,
+		},
 		{
 			name: "invalid second arg",
 			args: args{
 				v1: "1",
 				v2: "a",
 			},
-			want: false,
+			wantErr: true,
 		},
 		{
 			name: "second greater",
@@ -699,10 +4092,95 @@ func Test_versionGreaterThan(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "first greater",
+			args: args{
+				v1: "8.1.1",
+				v2: "7.23.1",
+			},
+			want: true,
+		},
+		{
+			name: "first greater in last component",
+			args: args{
+				v1: "7.23.1",
+				v2: "7.23.0",
+			},
+			want: true,
+		},
+		{
+			name: "second greater in middle component",
+			args: args{
+				v1: "7.22.5",
+				v2: "7.23.1",
+			},
+			want: false,
+		},
+		{
+			name: "second greater in first component with greater later components",
+			args: args{
+				v1: "7.24.1",
+				v2: "8.1.1",
+			},
+			want: false,
+		},
+		{
+			name: "numeric comparison",
+			args: args{
+				v1: "7.23.10",
+				v2: "7.23.1",
+			},
+			want: true,
+		},
+		{
+			name: "numeric comparison reversed",
+			args: args{
+				v1: "7.23.1",
+				v2: "7.23.10",
+			},
+			want: false,
+		},
+		{
+			name: "equal",
+			args: args{
+				v1: "7.23.1",
+				v2: "7.23.1",
+			},
+			want: false,
+		},
+		{
+			name: "first longer",
+			args: args{
+				v1: "7.23.1",
+				v2: "7.23",
+			},
+			want: true,
+		},
+		{
+			name: "first longer with zero",
+			args: args{
+				v1: "7.23.0",
+				v2: "7.23",
+			},
+			want: false,
+		},
+		{
+			name: "second longer",
+			args: args{
+				v1: "7.23",
+				v2: "7.23.1",
+			},
+			want: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := versionGreaterThan(tt.args.v1, tt.args.v2); got != tt.want {
+			got, err := versionGreaterThan(tt.args.v1, tt.args.v2)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("versionGreaterThan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
 				t.Errorf("versionGreaterThan() = %v, want %v", got, tt.want)
 			}
 		})
@@ -715,6 +4193,28 @@ func dataDragonResponseDoer(object interface{}) internal.Doer {
 	}, 200)
 }
 
+// endpointResponseDoer returns a doer which responds with the json representation of the object registered for the
+// requested endpoint. Requests to unknown endpoints are answered with 404.
+func endpointResponseDoer(responses map[string]interface{}) internal.Doer {
+	return &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			for endpoint, object := range responses {
+				if strings.HasSuffix(r.URL.Path, endpoint) {
+					buffer, err := json.Marshal(object)
+					if err != nil {
+						return nil, err
+					}
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       &mock.ResponseBody{Content: buffer},
+					}, nil
+				}
+			}
+			return &http.Response{StatusCode: http.StatusNotFound}, nil
+		},
+	}
+}
+
 type errorReadCloser struct{}
 
 func (e errorReadCloser) Read(p []byte) (n int, err error) {
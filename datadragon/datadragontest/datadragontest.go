@@ -0,0 +1,297 @@
+// Package datadragontest provides a Data Dragon client for tests which serves fixture data instead of requesting the
+// Data Dragon service.
+//
+// Example:
+//   data := datadragontest.DefaultTestData()
+//   client := datadragontest.NewTestClient(data)
+//   champion, _ := client.GetChampion("Aatrox")
+package datadragontest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/datadragon"
+)
+
+const (
+	// DefaultVersion is the version served if none is set in the test data
+	DefaultVersion = "13.24.1"
+	// DefaultLanguage is the language served if none is set in the test data
+	DefaultLanguage = datadragon.LanguageCodeUnitedStates
+	// legacyRuneVersion is the last version containing the data of the legacy rune and mastery systems
+	legacyRuneVersion = "7.23.1"
+)
+
+// TestData contains the data served by a test client
+type TestData struct {
+	// Version is the current version of the client, DefaultVersion if empty
+	Version string
+	// Language is the current language of the client, DefaultLanguage if empty
+	Language  datadragon.LanguageCode
+	Champions []datadragon.ChampionDataExtended
+	// Items are listed in the store like the items of the item data file, regardless of their InStore field, unless
+	// their id is contained in ItemsNotInStore
+	Items           []datadragon.Item
+	ItemsNotInStore []string
+	SummonerSpells  []datadragon.SummonerSpell
+	ProfileIcons    []datadragon.ProfileIcon
+	ReforgedRunes   []datadragon.RuneReforgedPath
+	Maps            []datadragon.GameMap
+	// Masteries are served for the legacy rune version 7.23.1 if any are set, as they do not exist for later versions
+	Masteries    []datadragon.Mastery
+	TFTChampions []datadragon.TFTChampion
+	TFTItems     []datadragon.TFTItem
+	TFTTraits    []datadragon.TFTTrait
+	TFTAugments  []datadragon.TFTAugment
+	Challenges   []datadragon.Challenge
+	// AdditionalFiles contains further objects which are served encoded as JSON by the name of their data file, e.g.
+	// "tft-tactician.json"
+	AdditionalFiles map[string]interface{}
+}
+
+// DefaultTestData returns a small set of fixture data containing a few champions, items and summoner spells
+func DefaultTestData() TestData {
+	return TestData{
+		Champions: []datadragon.ChampionDataExtended{
+			{
+				ChampionData: datadragon.ChampionData{
+					ID:    "Aatrox",
+					Key:   "266",
+					Name:  "Aatrox",
+					Title: "the Darkin Blade",
+					Tags:  []string{"Fighter", "Tank"},
+				},
+				Skins: []datadragon.SkinData{{ID: "266000", Name: "default"}},
+			},
+			{
+				ChampionData: datadragon.ChampionData{
+					ID:    "Ahri",
+					Key:   "103",
+					Name:  "Ahri",
+					Title: "the Nine-Tailed Fox",
+					Tags:  []string{"Mage", "Assassin"},
+				},
+				Skins: []datadragon.SkinData{{ID: "103000", Name: "default"}},
+			},
+		},
+		Items: []datadragon.Item{
+			{ID: "1001", Name: "Boots", Into: []string{"3006"}},
+			{ID: "3006", Name: "Berserker's Greaves", From: []string{"1001"}},
+		},
+		SummonerSpells: []datadragon.SummonerSpell{
+			{ID: "SummonerFlash", Key: "4", Name: "Flash"},
+			{ID: "SummonerDot", Key: "14", Name: "Ignite"},
+		},
+		ProfileIcons: []datadragon.ProfileIcon{{ID: 0}},
+		Maps:         []datadragon.GameMap{{ID: 11, Name: "Summoner's Rift"}},
+	}
+}
+
+// NewTestClient returns a Data Dragon client which serves the given data. Any request for data which is not contained
+// in the test data results in api.ErrNotFound.
+func NewTestClient(data TestData, options ...datadragon.Option) *datadragon.Client {
+	if data.Version == "" {
+		data.Version = DefaultVersion
+	}
+	if data.Language == "" {
+		data.Language = DefaultLanguage
+	}
+	if len(data.Masteries) > 0 {
+		options = append([]datadragon.Option{datadragon.WithLegacyRuneVersion(legacyRuneVersion)}, options...)
+	}
+	return datadragon.NewClient(newDoer(data), api.RegionNorthAmerica, nil, options...)
+}
+
+// doer answers requests to the Data Dragon service with the encoded test data
+type doer struct {
+	// files contains the encoded test data by the path of the request relative to the data directory of the current
+	// version and language, e.g. "champion.json"
+	files    map[string][]byte
+	version  string
+	language datadragon.LanguageCode
+}
+
+func newDoer(data TestData) *doer {
+	d := &doer{files: map[string][]byte{}, version: data.Version, language: data.Language}
+	for _, champion := range data.Champions {
+		d.setData(data, "champion/"+champion.ID+".json", map[string]datadragon.ChampionDataExtended{
+			champion.ID: champion,
+		})
+	}
+	for _, file := range dataFiles {
+		objects := map[string]interface{}{}
+		for i := 0; i < file.count(data); i++ {
+			id, object := file.entry(data, i)
+			objects[id] = object
+		}
+		d.setData(data, file.name, objects)
+	}
+	d.setFile("runesReforged.json", data.ReforgedRunes)
+	d.setFile("challenges.json", data.Challenges)
+	for name, object := range data.AdditionalFiles {
+		d.setFile(name, object)
+	}
+	return d
+}
+
+// dataFiles are the data files served by the doer which contain the objects of the test data by their id
+var dataFiles = []struct {
+	name  string
+	count func(data TestData) int
+	entry func(data TestData, i int) (string, interface{})
+}{
+	{
+		name:  "champion.json",
+		count: func(data TestData) int { return len(data.Champions) },
+		entry: func(data TestData, i int) (string, interface{}) {
+			return data.Champions[i].ID, data.Champions[i].ChampionData
+		},
+	},
+	{
+		name:  "item.json",
+		count: func(data TestData) int { return len(data.Items) },
+		entry: func(data TestData, i int) (string, interface{}) {
+			item := data.Items[i]
+			// the item data file only states inStore for items which are not listed in the store
+			return item.ID, itemData{Item: item, InStore: inStore(!contains(data.ItemsNotInStore, item.ID))}
+		},
+	},
+	{
+		name:  "summoner.json",
+		count: func(data TestData) int { return len(data.SummonerSpells) },
+		entry: func(data TestData, i int) (string, interface{}) {
+			return data.SummonerSpells[i].ID, data.SummonerSpells[i]
+		},
+	},
+	{
+		name:  "profileicon.json",
+		count: func(data TestData) int { return len(data.ProfileIcons) },
+		entry: func(data TestData, i int) (string, interface{}) {
+			return strconv.Itoa(data.ProfileIcons[i].ID), data.ProfileIcons[i]
+		},
+	},
+	{
+		name:  "map.json",
+		count: func(data TestData) int { return len(data.Maps) },
+		entry: func(data TestData, i int) (string, interface{}) {
+			return strconv.Itoa(data.Maps[i].ID), data.Maps[i]
+		},
+	},
+	{
+		name:  "mastery.json",
+		count: func(data TestData) int { return len(data.Masteries) },
+		entry: func(data TestData, i int) (string, interface{}) {
+			return strconv.Itoa(data.Masteries[i].ID), data.Masteries[i]
+		},
+	},
+	{
+		name:  "tft-champion.json",
+		count: func(data TestData) int { return len(data.TFTChampions) },
+		entry: func(data TestData, i int) (string, interface{}) {
+			return data.TFTChampions[i].ID, data.TFTChampions[i]
+		},
+	},
+	{
+		name:  "tft-item.json",
+		count: func(data TestData) int { return len(data.TFTItems) },
+		entry: func(data TestData, i int) (string, interface{}) {
+			return data.TFTItems[i].ID, data.TFTItems[i]
+		},
+	},
+	{
+		name:  "tft-trait.json",
+		count: func(data TestData) int { return len(data.TFTTraits) },
+		entry: func(data TestData, i int) (string, interface{}) {
+			return data.TFTTraits[i].ID, data.TFTTraits[i]
+		},
+	},
+	{
+		name:  "tft-augments.json",
+		count: func(data TestData) int { return len(data.TFTAugments) },
+		entry: func(data TestData, i int) (string, interface{}) {
+			return data.TFTAugments[i].ID, data.TFTAugments[i]
+		},
+	},
+}
+
+// contains reports whether the id is contained in the list of ids
+func contains(ids []string, id string) bool {
+	for _, other := range ids {
+		if other == id {
+			return true
+		}
+	}
+	return false
+}
+
+// itemData is an item as it is contained in the item data file
+type itemData struct {
+	datadragon.Item
+	InStore *bool `json:"inStore,omitempty"`
+}
+
+// inStore returns the inStore value of the item data file for an item, which is omitted for items in the store
+func inStore(listed bool) *bool {
+	if listed {
+		return nil
+	}
+	return &listed
+}
+
+// setData stores the given object wrapped in a Data Dragon response object
+func (d *doer) setData(data TestData, name string, object interface{}) {
+	d.setFile(name, struct {
+		Type    string      `json:"type"`
+		Format  string      `json:"format"`
+		Version string      `json:"version"`
+		Data    interface{} `json:"data"`
+	}{
+		Type:    strings.TrimSuffix(path.Base(name), ".json"),
+		Format:  "standAloneComplex",
+		Version: data.Version,
+		Data:    object,
+	})
+}
+
+// setFile stores the given object as a data file. Objects which can not be encoded are ignored.
+func (d *doer) setFile(name string, object interface{}) {
+	content, err := json.Marshal(object)
+	if err != nil {
+		return
+	}
+	d.files[name] = content
+}
+
+func (d *doer) Do(r *http.Request) (*http.Response, error) {
+	var content []byte
+	// data files are located at /cdn/<version>/data/<language>/..., the version of legacy data files differs from the
+	// current version
+	split := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/cdn/"), "/", 4)
+	switch p := r.URL.Path; {
+	case strings.HasPrefix(p, "/realms/"):
+		content, _ = json.Marshal(map[string]string{"v": d.version, "l": string(d.language)})
+	case p == "/api/versions.json":
+		content, _ = json.Marshal([]string{d.version})
+	case p == "/cdn/languages.json":
+		content, _ = json.Marshal([]datadragon.LanguageCode{d.language})
+	case len(split) == 4 && split[1] == "data" && split[2] == string(d.language):
+		content = d.files[split[3]]
+	}
+	if content == nil {
+		return &http.Response{
+			StatusCode: http.StatusNotFound,
+			Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+		}, nil
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       ioutil.NopCloser(bytes.NewReader(content)),
+	}, nil
+}
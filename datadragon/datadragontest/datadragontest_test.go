@@ -0,0 +1,78 @@
+package datadragontest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/datadragon"
+)
+
+func TestNewTestClient(t *testing.T) {
+	t.Parallel()
+	c := NewTestClient(DefaultTestData())
+	assert.Equal(t, DefaultVersion, c.GetVersion())
+	champions, err := c.GetChampions()
+	require.Nil(t, err)
+	assert.Len(t, champions, 2)
+	champion, err := c.GetChampionByID("266")
+	require.Nil(t, err)
+	assert.Equal(t, "Aatrox", champion.Name)
+	assert.Equal(t, "266000", champion.Skins[0].ID)
+	item, err := c.GetItem("3006")
+	require.Nil(t, err)
+	assert.Equal(t, "Berserker's Greaves", item.Name)
+	spell, err := c.GetSummonerSpellByKey("4")
+	require.Nil(t, err)
+	assert.Equal(t, "Flash", spell.Name)
+	gameMap, err := c.GetMap(11)
+	require.Nil(t, err)
+	assert.Equal(t, "Summoner's Rift", gameMap.Name)
+	_, err = c.GetChampion("Zed")
+	assert.Equal(t, api.ErrNotFound, err)
+}
+
+func TestNewTestClient_custom(t *testing.T) {
+	t.Parallel()
+	c := NewTestClient(TestData{
+		Version:  "9.10.1",
+		Language: datadragon.LanguageCodeGermany,
+		Items:    []datadragon.Item{{ID: "1001", Name: "Stiefel"}},
+		AdditionalFiles: map[string]interface{}{
+			"tft-tactician.json": map[string]interface{}{"data": map[string]string{"1": "Silverwing"}},
+		},
+	})
+	assert.Equal(t, "9.10.1", c.GetVersion())
+	item, err := c.GetItem("1001")
+	require.Nil(t, err)
+	assert.Equal(t, "Stiefel", item.Name)
+	var tacticians map[string]string
+	require.Nil(t, c.GetRaw("/tft-tactician.json", &tacticians))
+	assert.Equal(t, map[string]string{"1": "Silverwing"}, tacticians)
+	_, err = c.GetItem("1004")
+	assert.True(t, errors.Is(err, api.ErrNotFound))
+}
+
+func TestNewTestClient_decoding(t *testing.T) {
+	t.Parallel()
+	c := NewTestClient(TestData{
+		Items: []datadragon.Item{
+			{ID: "1001", Name: "Boots"},
+			{ID: "3340", Name: "Stealth Ward", InStore: true},
+		},
+		ItemsNotInStore: []string{"3340"},
+		Masteries:       []datadragon.Mastery{{ID: 6111, Name: "Fury"}},
+	})
+	boots, err := c.GetItem("1001")
+	require.Nil(t, err)
+	assert.True(t, boots.InStore)
+	ward, err := c.GetItem("3340")
+	require.Nil(t, err)
+	assert.False(t, ward.InStore)
+	mastery, err := c.GetMastery(6111)
+	require.Nil(t, err)
+	assert.Equal(t, "Fury", mastery.Name)
+}
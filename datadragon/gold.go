@@ -0,0 +1,41 @@
+package datadragon
+
+import "fmt"
+
+// DefaultStatGoldValues returns the gold value of one unit of each item stat by the stat key of Data Dragon, e.g.
+// 35 for FlatPhysicalDamageMod. The values are derived from the cost of the basic items providing only the stat.
+// Percent stats are stored as fractions, so the value of 1% attack speed is a hundredth of the value of
+// PercentAttackSpeedMod.
+func DefaultStatGoldValues() map[string]float64 {
+	return map[string]float64{
+		"FlatPhysicalDamageMod":   35,
+		"FlatMagicDamageMod":      21.75,
+		"FlatArmorMod":            20,
+		"FlatSpellBlockMod":       18,
+		"FlatHPPoolMod":           2.67,
+		"FlatMPPoolMod":           1.4,
+		"FlatMovementSpeedMod":    12,
+		"PercentMovementSpeedMod": 3950,
+		"PercentAttackSpeedMod":   2500,
+		"FlatCritChanceMod":       4000,
+		"PercentLifeStealMod":     3750,
+	}
+}
+
+// ItemGoldEfficiency returns the ratio between the gold value of the stats of the item and its total cost, e.g. 1.1
+// for an item whose stats are worth 10% more than its cost. The stat values are DefaultStatGoldValues unless set with
+// WithStatGoldValues; stats without a value and passive effects are not considered.
+func (c *Client) ItemGoldEfficiency(item Item) (float64, error) {
+	if item.Gold.Total <= 0 {
+		return 0, fmt.Errorf("item %s has no cost", item.ID)
+	}
+	values := c.statGoldValues
+	if values == nil {
+		values = DefaultStatGoldValues()
+	}
+	var worth float64
+	for key, stat := range item.Stats.Map() {
+		worth += stat * values[key]
+	}
+	return worth / float64(item.Gold.Total), nil
+}
@@ -0,0 +1,45 @@
+package datadragon
+
+import (
+	"math"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+func TestClient_ItemGoldEfficiency(t *testing.T) {
+	t.Parallel()
+	longSword := Item{ID: "1036", Stats: ItemStats{FlatPhysicalDamageMod: 10}}
+	longSword.Gold.Total = 350
+	infinityEdge := Item{ID: "3031", Stats: ItemStats{FlatPhysicalDamageMod: 70, FlatCritChanceMod: 0.2}}
+	infinityEdge.Gold.Total = 3400
+	tests := []struct {
+		name    string
+		options []Option
+		item    Item
+		want    float64
+		wantErr bool
+	}{
+		{name: "basic item", item: longSword, want: 1},
+		{name: "multiple stats", item: infinityEdge, want: (70*35 + 0.2*4000) / 3400.0},
+		{
+			name:    "custom values",
+			options: []Option{WithStatGoldValues(map[string]float64{"FlatPhysicalDamageMod": 70})},
+			item:    longSword,
+			want:    2,
+		},
+		{name: "no stats", item: Item{ID: "2003", Gold: longSword.Gold}},
+		{name: "no cost", item: Item{ID: "3340"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newClient(&mock.Doer{}, log.StandardLogger(), tt.options...)
+			got, err := c.ItemGoldEfficiency(tt.item)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.True(t, math.Abs(tt.want-got) < 1e-9, "got %f, want %f", got, tt.want)
+		})
+	}
+}
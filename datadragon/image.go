@@ -0,0 +1,144 @@
+package datadragon
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"io"
+	"net/http"
+
+	"github.com/KnutZuidema/golio/api"
+)
+
+// ChampionSquareImageURL returns the URL of the square icon of the given champion for the current version
+func (c *Client) ChampionSquareImageURL(champion ChampionData) string {
+	return c.url(dataDragonImageURLFormat, "/champion/"+champion.Image.Full)
+}
+
+// ChampionSplashImageURL returns the URL of the splash art of the skin with the given number for the champion with
+// the given name. Skin number 0 is the base skin. Splash art is not versioned.
+func (c *Client) ChampionSplashImageURL(championName string, skinNum int) string {
+	return c.url(dataDragonStaticImageURL, fmt.Sprintf("/champion/splash/%s_%d.jpg", championName, skinNum))
+}
+
+// SkinSplashImageURL returns the URL of the splash art of the given skin of the given champion
+func (c *Client) SkinSplashImageURL(champion ChampionData, skin SkinData) string {
+	return c.ChampionSplashImageURL(champion.ID, skin.Num)
+}
+
+// ChampionSkinSplashURLs returns the URLs of the splash arts of all skins of the champion with the given name by the
+// names of the skins. The base skin is named "default".
+func (c *Client) ChampionSkinSplashURLs(name string) (map[string]string, error) {
+	return c.ChampionSkinSplashURLsCtx(context.Background(), name)
+}
+
+// ChampionSkinSplashURLsCtx is like ChampionSkinSplashURLs but uses the given context for all requests
+func (c *Client) ChampionSkinSplashURLsCtx(ctx context.Context, name string) (map[string]string, error) {
+	champion, err := c.GetChampionCtx(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string]string, len(champion.Skins))
+	for _, skin := range champion.Skins {
+		res[skin.Name] = c.SkinSplashImageURL(champion.ChampionData, skin)
+	}
+	return res, nil
+}
+
+// ChampionLoadingImageURL returns the URL of the loading screen art of the skin with the given number for the
+// champion with the given name. Skin number 0 is the base skin. Loading screen art is not versioned.
+func (c *Client) ChampionLoadingImageURL(championName string, skinNum int) string {
+	return c.url(dataDragonStaticImageURL, fmt.Sprintf("/champion/loading/%s_%d.jpg", championName, skinNum))
+}
+
+// PassiveImageURL returns the URL of the icon of the passive ability of the given champion for the current version
+func (c *Client) PassiveImageURL(champion ChampionDataExtended) string {
+	return c.url(dataDragonImageURLFormat, "/passive/"+champion.Passive.Image.Full)
+}
+
+// SpellImageURL returns the URL of the icon of the given champion spell for the current version
+func (c *Client) SpellImageURL(spell SpellData) string {
+	return c.url(dataDragonImageURLFormat, "/spell/"+spell.Image.Full)
+}
+
+// ItemImageURL returns the URL of the icon of the given item for the current version
+func (c *Client) ItemImageURL(item Item) string {
+	return c.url(dataDragonImageURLFormat, "/item/"+item.Image.Full)
+}
+
+// ProfileIconImageURL returns the URL of the given profile icon for the current version
+func (c *Client) ProfileIconImageURL(icon ProfileIcon) string {
+	return c.url(dataDragonImageURLFormat, "/profileicon/"+icon.Image.Full)
+}
+
+// TFTChampionImageURL returns the URL of the icon of the given Teamfight Tactics champion for the current version
+func (c *Client) TFTChampionImageURL(champion TFTChampion) string {
+	return c.url(dataDragonImageURLFormat, "/tft-champion/"+champion.Image.Full)
+}
+
+// TFTItemImageURL returns the URL of the icon of the given Teamfight Tactics item for the current version
+func (c *Client) TFTItemImageURL(item TFTItem) string {
+	return c.url(dataDragonImageURLFormat, "/tft-item/"+item.Image.Full)
+}
+
+// TFTTraitImageURL returns the URL of the icon of the given Teamfight Tactics trait for the current version
+func (c *Client) TFTTraitImageURL(trait TFTTrait) string {
+	return c.url(dataDragonImageURLFormat, "/tft-trait/"+trait.Image.Full)
+}
+
+// SpriteImageURL returns the URL of the sprite sheet which contains the given image for the current version
+func (c *Client) SpriteImageURL(img ImageData) string {
+	return c.url(dataDragonImageURLFormat, "/sprite/"+img.Sprite)
+}
+
+// SpriteRect returns the area of the sprite sheet returned by SpriteImageURL which is covered by the given image
+func SpriteRect(img ImageData) image.Rectangle {
+	return image.Rect(img.X, img.Y, img.X+img.W, img.Y+img.H)
+}
+
+// GetImage returns the content of the image at the given URL, e.g. one returned by ChampionSquareImageURL.
+// The request is made using the client of the Data Dragon client. The caller is responsible for closing the returned
+// reader.
+func (c *Client) GetImage(url string) (io.ReadCloser, error) {
+	return c.GetImageCtx(context.Background(), url)
+}
+
+// GetImageCtx is like GetImage but uses the given context for the request
+func (c *Client) GetImageCtx(ctx context.Context, url string) (io.ReadCloser, error) {
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	c.setHeaders(request)
+	response, err := c.do(request.WithContext(ctx), request.URL.Path)
+	if err != nil {
+		return nil, err
+	}
+	return response.Body, nil
+}
+
+// ImageExists reports whether an image exists at the given URL, e.g. one returned by SkinSplashImageURL, without
+// downloading it. Data Dragon responds with 403 or 404 for images which do not exist.
+func (c *Client) ImageExists(url string) (bool, error) {
+	return c.ImageExistsCtx(context.Background(), url)
+}
+
+// ImageExistsCtx is like ImageExists but uses the given context for the request
+func (c *Client) ImageExistsCtx(ctx context.Context, url string) (bool, error) {
+	request, err := http.NewRequest("HEAD", url, nil)
+	if err != nil {
+		return false, err
+	}
+	c.setHeaders(request)
+	response, err := c.do(request.WithContext(ctx), request.URL.Path)
+	if err == api.ErrNotFound || err == api.ErrForbidden {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if response.Body != nil {
+		response.Body.Close()
+	}
+	return true, nil
+}
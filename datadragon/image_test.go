@@ -0,0 +1,274 @@
+package datadragon
+
+import (
+	"image"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+func TestClient_ChampionSquareImageURL(t *testing.T) {
+	t.Parallel()
+	c := &Client{version: "9.10.1"}
+	got := c.ChampionSquareImageURL(ChampionData{Image: ImageData{Full: "Aatrox.png"}})
+	assert.Equal(t, "https://ddragon.leagueoflegends.com/cdn/9.10.1/img/champion/Aatrox.png", got)
+}
+
+func TestClient_ChampionSplashImageURL(t *testing.T) {
+	t.Parallel()
+	c := &Client{version: "9.10.1"}
+	got := c.ChampionSplashImageURL("Aatrox", 0)
+	assert.Equal(t, "https://ddragon.leagueoflegends.com/cdn/img/champion/splash/Aatrox_0.jpg", got)
+}
+
+func TestClient_SkinSplashImageURL(t *testing.T) {
+	t.Parallel()
+	c := &Client{version: "9.10.1"}
+	got := c.SkinSplashImageURL(ChampionData{ID: "MonkeyKing", Name: "Wukong"}, SkinData{ID: "62001", Num: 1})
+	assert.Equal(t, "https://ddragon.leagueoflegends.com/cdn/img/champion/splash/MonkeyKing_1.jpg", got)
+}
+
+func TestClient_ChampionSkinSplashURLs(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		doer    internal.Doer
+		want    map[string]string
+		wantErr error
+	}{
+		{
+			name: "get response",
+			doer: dataDragonResponseDoer(map[string]ChampionDataExtended{
+				"MonkeyKing": {
+					ChampionData: ChampionData{ID: "MonkeyKing", Name: "Wukong"},
+					Skins: []SkinData{
+						{ID: "62000", Name: "default"},
+						{ID: "62001", Num: 1, Name: "Volcanic Wukong"},
+					},
+				},
+			}),
+			want: map[string]string{
+				"default":         "https://ddragon.leagueoflegends.com/cdn/img/champion/splash/MonkeyKing_0.jpg",
+				"Volcanic Wukong": "https://ddragon.leagueoflegends.com/cdn/img/champion/splash/MonkeyKing_1.jpg",
+			},
+		},
+		{
+			name:    "known error",
+			doer:    mock.NewStatusMockDoer(http.StatusForbidden),
+			wantErr: api.ErrForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(tt.doer, api.RegionEuropeWest, log.StandardLogger())
+			got, err := c.ChampionSkinSplashURLs("MonkeyKing")
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_ChampionLoadingImageURL(t *testing.T) {
+	t.Parallel()
+	c := &Client{version: "9.10.1"}
+	got := c.ChampionLoadingImageURL("Aatrox", 2)
+	assert.Equal(t, "https://ddragon.leagueoflegends.com/cdn/img/champion/loading/Aatrox_2.jpg", got)
+}
+
+func TestClient_PassiveImageURL(t *testing.T) {
+	t.Parallel()
+	c := &Client{version: "9.10.1"}
+	got := c.PassiveImageURL(ChampionDataExtended{Passive: PassiveData{Image: ImageData{Full: "Aatrox_Passive.png"}}})
+	assert.Equal(t, "https://ddragon.leagueoflegends.com/cdn/9.10.1/img/passive/Aatrox_Passive.png", got)
+}
+
+func TestClient_SpellImageURL(t *testing.T) {
+	t.Parallel()
+	c := &Client{version: "9.10.1"}
+	got := c.SpellImageURL(SpellData{Image: ImageData{Full: "AatroxQ.png"}})
+	assert.Equal(t, "https://ddragon.leagueoflegends.com/cdn/9.10.1/img/spell/AatroxQ.png", got)
+}
+
+func TestClient_ItemImageURL(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		version string
+		want    string
+	}{
+		{
+			name:    "current version",
+			version: "9.10.1",
+			want:    "https://ddragon.leagueoflegends.com/cdn/9.10.1/img/item/1001.png",
+		},
+		{
+			name:    "other version",
+			version: "13.24.1",
+			want:    "https://ddragon.leagueoflegends.com/cdn/13.24.1/img/item/1001.png",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{version: tt.version}
+			assert.Equal(t, tt.want, c.ItemImageURL(Item{Image: ImageData{Full: "1001.png"}}))
+		})
+	}
+}
+
+func TestClient_ProfileIconImageURL(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		version string
+		want    string
+	}{
+		{
+			name:    "current version",
+			version: "9.10.1",
+			want:    "https://ddragon.leagueoflegends.com/cdn/9.10.1/img/profileicon/588.png",
+		},
+		{
+			name:    "other version",
+			version: "13.24.1",
+			want:    "https://ddragon.leagueoflegends.com/cdn/13.24.1/img/profileicon/588.png",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{version: tt.version}
+			assert.Equal(t, tt.want, c.ProfileIconImageURL(ProfileIcon{Image: ImageData{Full: "588.png"}}))
+		})
+	}
+}
+
+func TestClient_TFTImageURL(t *testing.T) {
+	t.Parallel()
+	c := &Client{version: "13.24.1"}
+	assert.Equal(t, "https://ddragon.leagueoflegends.com/cdn/13.24.1/img/tft-champion/TFT10_Ahri.TFT_Set10.png",
+		c.TFTChampionImageURL(TFTChampion{Image: ImageData{Full: "TFT10_Ahri.TFT_Set10.png"}}))
+	assert.Equal(t, "https://ddragon.leagueoflegends.com/cdn/13.24.1/img/tft-item/TFT_Item_BFSword.png",
+		c.TFTItemImageURL(TFTItem{Image: ImageData{Full: "TFT_Item_BFSword.png"}}))
+	assert.Equal(t, "https://ddragon.leagueoflegends.com/cdn/13.24.1/img/tft-trait/Trait_Icon_3_Bruiser.png",
+		c.TFTTraitImageURL(TFTTrait{Image: ImageData{Full: "Trait_Icon_3_Bruiser.png"}}))
+}
+
+func TestClient_SpriteImageURL(t *testing.T) {
+	t.Parallel()
+	c := &Client{version: "9.10.1"}
+	got := c.SpriteImageURL(ImageData{Sprite: "champion0.png"})
+	assert.Equal(t, "https://ddragon.leagueoflegends.com/cdn/9.10.1/img/sprite/champion0.png", got)
+}
+
+func TestSpriteRect(t *testing.T) {
+	t.Parallel()
+	got := SpriteRect(ImageData{Sprite: "champion0.png", X: 48, Y: 96, W: 48, H: 48})
+	assert.Equal(t, image.Rect(48, 96, 96, 144), got)
+	assert.Equal(t, 48, got.Dx())
+	assert.Equal(t, 48, got.Dy())
+}
+
+func TestClient_GetImage(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		url     string
+		doer    internal.Doer
+		want    []byte
+		wantErr error
+	}{
+		{
+			name: "get response",
+			url:  "https://ddragon.leagueoflegends.com/cdn/9.10.1/img/champion/Aatrox.png",
+			doer: &mock.Doer{
+				Custom: func(r *http.Request) (*http.Response, error) {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       &mock.ResponseBody{Content: []byte("image")},
+					}, nil
+				},
+			},
+			want: []byte("image"),
+		},
+		{
+			name:    "known error",
+			url:     "https://ddragon.leagueoflegends.com/cdn/9.10.1/img/champion/Aatrox.png",
+			doer:    mock.NewStatusMockDoer(http.StatusForbidden),
+			wantErr: api.ErrForbidden,
+		},
+		{
+			name: "unknown error",
+			url:  "https://ddragon.leagueoflegends.com/cdn/9.10.1/img/champion/Aatrox.png",
+			doer: mock.NewStatusMockDoer(999),
+			wantErr: api.Error{
+				Message:    "unknown error reason",
+				StatusCode: 999,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(tt.doer, api.RegionEuropeWest, log.StandardLogger())
+			got, err := c.GetImage(tt.url)
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				content, err := ioutil.ReadAll(got)
+				assert.Nil(t, err)
+				assert.Equal(t, tt.want, content)
+			}
+		})
+	}
+}
+
+func TestClient_ImageExists(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		doer    internal.Doer
+		want    bool
+		wantErr error
+	}{
+		{
+			name: "exists",
+			doer: &mock.Doer{
+				Custom: func(r *http.Request) (*http.Response, error) {
+					assert.Equal(t, "HEAD", r.Method)
+					return &http.Response{StatusCode: http.StatusOK, Body: &mock.ResponseBody{}}, nil
+				},
+			},
+			want: true,
+		},
+		{
+			name: "not found",
+			doer: mock.NewStatusMockDoer(http.StatusNotFound),
+			want: false,
+		},
+		{
+			name: "forbidden",
+			doer: mock.NewStatusMockDoer(http.StatusForbidden),
+			want: false,
+		},
+		{
+			name: "unknown error",
+			doer: mock.NewStatusMockDoer(999),
+			wantErr: api.Error{
+				Message:    "unknown error reason",
+				StatusCode: 999,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newClient(tt.doer, log.StandardLogger())
+			got, err := c.ImageExists("https://ddragon.leagueoflegends.com/cdn/img/champion/splash/Aatrox_99.jpg")
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
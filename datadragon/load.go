@@ -0,0 +1,90 @@
+package datadragon
+
+import (
+	"io"
+	"io/ioutil"
+	"sync/atomic"
+	"time"
+)
+
+// LoadChampionsFromReader replaces the champion cache with the champions of the champion.json data file read from r,
+// e.g. a file embedded with go:embed. Champions loaded this way are served without any further requests, extended
+// information of a champion is still retrieved from the Data Dragon service when it is requested.
+func (c *Client) LoadChampionsFromReader(r io.Reader) error {
+	body, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	var champions map[string]ChampionData
+	if err := decodeData(body, &champions); err != nil {
+		return err
+	}
+	c.championsMu.Lock()
+	defer c.championsMu.Unlock()
+	c.championsByID = make(map[string]ChampionDataExtended, len(champions))
+	c.championIDsByKey = make(map[string]string, len(champions))
+	for id, champion := range champions {
+		c.setChampion(id, ChampionDataExtended{ChampionData: champion})
+	}
+	atomic.StoreUint32(&c.getChampionsToggle, 1)
+	c.championsUpdated = time.Now()
+	return nil
+}
+
+// LoadItemsFromReader replaces the item cache with the items of the item.json data file read from r
+func (c *Client) LoadItemsFromReader(r io.Reader) error {
+	body, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	c.itemsMu.Lock()
+	defer c.itemsMu.Unlock()
+	return c.loadItems(body)
+}
+
+// LoadSummonerSpellsFromReader replaces the summoner spell cache with the summoner spells of the summoner.json data
+// file read from r
+func (c *Client) LoadSummonerSpellsFromReader(r io.Reader) error {
+	body, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	c.summonersMu.Lock()
+	defer c.summonersMu.Unlock()
+	return c.loadSummonerSpells(body)
+}
+
+// LoadProfileIconsFromReader replaces the profile icon cache with the profile icons of the profileicon.json data file
+// read from r
+func (c *Client) LoadProfileIconsFromReader(r io.Reader) error {
+	body, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	c.profileIconsMu.Lock()
+	defer c.profileIconsMu.Unlock()
+	return c.loadProfileIcons(body)
+}
+
+// LoadReforgedRunesFromReader replaces the Runes Reforged cache with the rune paths of the runesReforged.json data
+// file read from r
+func (c *Client) LoadReforgedRunesFromReader(r io.Reader) error {
+	body, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	c.reforgedRunesMu.Lock()
+	defer c.reforgedRunesMu.Unlock()
+	return c.loadReforgedRunes(body)
+}
+
+// LoadMapsFromReader replaces the map cache with the maps of the map.json data file read from r
+func (c *Client) LoadMapsFromReader(r io.Reader) error {
+	body, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	c.mapsMu.Lock()
+	defer c.mapsMu.Unlock()
+	return c.loadMaps(body)
+}
@@ -0,0 +1,70 @@
+package datadragon
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+func TestClient_LoadFromReader(t *testing.T) {
+	t.Parallel()
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			t.Errorf("unexpected request to %s", r.URL)
+			return &http.Response{StatusCode: http.StatusNotFound}, nil
+		},
+	}
+	c := newClient(doer, log.StandardLogger())
+	c.version, c.language = "13.24.1", LanguageCodeUnitedStates
+	load := func(file string, loader func(c *Client, r io.Reader) error) {
+		f, err := os.Open(filepath.Join("testdata", "data", file))
+		require.Nil(t, err)
+		defer f.Close()
+		require.Nil(t, loader(c, f))
+	}
+	load("champion.json", (*Client).LoadChampionsFromReader)
+	load("item.json", (*Client).LoadItemsFromReader)
+	load("summoner.json", (*Client).LoadSummonerSpellsFromReader)
+	load("profileicon.json", (*Client).LoadProfileIconsFromReader)
+	load("runesReforged.json", (*Client).LoadReforgedRunesFromReader)
+	load("map.json", (*Client).LoadMapsFromReader)
+
+	champions, err := c.GetChampions()
+	require.Nil(t, err)
+	var ids []string
+	for _, champion := range champions {
+		ids = append(ids, champion.ID)
+	}
+	assert.ElementsMatch(t, []string{"Aatrox", "MonkeyKing"}, ids)
+	item, err := c.GetItem("1001")
+	require.Nil(t, err)
+	assert.Equal(t, "1001", item.ID)
+	spell, err := c.GetSummonerSpellByKey("4")
+	require.Nil(t, err)
+	assert.Equal(t, "SummonerFlash", spell.ID)
+	_, err = c.GetProfileIcon(588)
+	assert.Nil(t, err)
+	_, err = c.GetReforgedRune(8005)
+	assert.Nil(t, err)
+	gameMap, err := c.GetMap(11)
+	require.Nil(t, err)
+	assert.Equal(t, "Summoner's Rift", gameMap.Name)
+}
+
+func TestClient_LoadFromReader_invalid(t *testing.T) {
+	t.Parallel()
+	c := newClient(&mock.Doer{}, log.StandardLogger())
+	assert.NotNil(t, c.LoadChampionsFromReader(strings.NewReader("{")))
+	assert.NotNil(t, c.LoadItemsFromReader(strings.NewReader(`{"data": []}`)))
+	assert.NotNil(t, c.LoadReforgedRunesFromReader(strings.NewReader(`{}`)))
+	assert.NotNil(t, c.LoadMapsFromReader(errorReadCloser{}))
+}
@@ -1,6 +1,14 @@
 package datadragon
 
-// ChampionData contains information about a champion
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ChampionData contains information about a champion. Map specific balance changes, e.g. the damage dealt and taken
+// modifiers of ARAM, are not part of the Data Dragon data files and therefore not available.
 type ChampionData struct {
 	Version string            `json:"version"`
 	ID      string            `json:"id"`
@@ -63,6 +71,47 @@ type ChampionDataStats struct {
 	AttackSpeedPerLevel             float64 `json:"attackspeedperlevel"`
 }
 
+// AtLevel returns the stats of a champion with these stats at the given level. Stats grow by
+// growth * (level - 1) * (0.7025 + 0.0175 * (level - 1)) from level 1 onwards.
+func (s ChampionDataStats) AtLevel(level int) ChampionStats {
+	grow := func(base, growth float64) float64 {
+		return base + growth*statGrowthFactor(level)
+	}
+	return ChampionStats{
+		HealthPoints:            grow(s.HealthPoints, s.HealthPointsPerLevel),
+		ManaPoints:              grow(s.ManaPoints, s.ManaPointsPerLevel),
+		MovementSpeed:           s.MovementSpeed,
+		Armor:                   grow(s.Armor, s.ArmorPerLevel),
+		SpellBlock:              grow(s.SpellBlock, s.SpellBlockPerLevel),
+		AttackRange:             s.AttackRange,
+		HealthPointRegeneration: grow(s.HealthPointRegeneration, s.HealthPointRegenerationPerLevel),
+		ManaPointRegeneration:   grow(s.ManaPointRegeneration, s.ManaPointRegenerationPerLevel),
+		CriticalStrikeChance:    grow(s.CriticalStrikeChance, s.CriticalStrikeChancePerLevel),
+		AttackDamage:            grow(s.AttackDamage, s.AttackDamagePerLevel),
+		BonusAttackSpeed:        grow(0, s.AttackSpeedPerLevel),
+	}
+}
+
+func statGrowthFactor(level int) float64 {
+	return float64(level-1) * (0.7025 + 0.0175*float64(level-1))
+}
+
+// ChampionStats contains the stats of a champion at a specific level
+type ChampionStats struct {
+	HealthPoints            float64
+	ManaPoints              float64
+	MovementSpeed           float64
+	Armor                   float64
+	SpellBlock              float64
+	AttackRange             float64
+	HealthPointRegeneration float64
+	ManaPointRegeneration   float64
+	CriticalStrikeChance    float64
+	AttackDamage            float64
+	// BonusAttackSpeed is the attack speed gained from levels in percent of the base attack speed
+	BonusAttackSpeed float64
+}
+
 // ChampionDataExtended contains additional data about a champion
 type ChampionDataExtended struct {
 	ChampionData
@@ -87,7 +136,7 @@ type SkinData struct {
 type SpellData struct {
 	ID          string `json:"id"`
 	Name        string `json:"name"`
-	Description string `json:"abstract"`
+	Description string `json:"description"`
 	Tooltip     string `json:"tooltip"`
 	Leveltip    struct {
 		Label  []string `json:"label"`
@@ -113,6 +162,106 @@ type SpellData struct {
 	Resource  string    `json:"resource"`
 }
 
+// SpellCooldownAtRank returns the cooldown of the spell at the given rank between 1 and the maximum rank of the spell
+func SpellCooldownAtRank(spell SpellData, rank int) (float64, error) {
+	return spellValueAtRank(spell.Cooldown, rank)
+}
+
+// SpellCostAtRank returns the cost of the spell at the given rank between 1 and the maximum rank of the spell
+func SpellCostAtRank(spell SpellData, rank int) (float64, error) {
+	return spellValueAtRank(spell.Cost, rank)
+}
+
+// SpellRangeAtRank returns the range of the spell at the given rank between 1 and the maximum rank of the spell
+func SpellRangeAtRank(spell SpellData, rank int) (float64, error) {
+	return spellValueAtRank(spell.Range, rank)
+}
+
+func spellValueAtRank(values []float64, rank int) (float64, error) {
+	if rank < 1 || rank > len(values) {
+		return 0, fmt.Errorf("invalid rank %d", rank)
+	}
+	return values[rank-1], nil
+}
+
+// ParseBurn parses a burn string of per-rank values, e.g. "16/14/12/10/8" as it is used by CooldownBurn, into its
+// values. Values are separated by "/", surrounding whitespace including "&nbsp;" is ignored. An empty burn string
+// results in no values.
+func ParseBurn(s string) ([]float64, error) {
+	s = strings.TrimSpace(strings.Replace(s, "&nbsp;", " ", -1))
+	if s == "" {
+		return nil, nil
+	}
+	parts := strings.Split(s, "/")
+	values := make([]float64, len(parts))
+	for i, part := range parts {
+		value, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid burn value %q", part)
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+// CooldownBurnValues returns the parsed values of CooldownBurn
+func (s SpellData) CooldownBurnValues() ([]float64, error) {
+	return ParseBurn(s.CooldownBurn)
+}
+
+// CostBurnValues returns the parsed values of CostBurn
+func (s SpellData) CostBurnValues() ([]float64, error) {
+	return ParseBurn(s.CostBurn)
+}
+
+// RangeBurnValues returns the parsed values of RangeBurn. The range of spells without a range is "self" which results
+// in an error.
+func (s SpellData) RangeBurnValues() ([]float64, error) {
+	return ParseBurn(s.RangeBurn)
+}
+
+var tooltipPlaceholder = regexp.MustCompile(`{{\s*(\w+)\s*}}`)
+
+// ResolveSpellTooltip returns the tooltip of the spell with the placeholders replaced by their values at the given rank
+// between 1 and the maximum rank of the spell. Effects "{{ e1 }}" are taken from Effect, coefficients like
+// "{{ a1 }}" from Vars and "{{ cost }}" from Cost. Placeholders which can not be resolved from the spell data are kept.
+func ResolveSpellTooltip(spell SpellData, rank int) (string, error) {
+	if rank < 1 || rank > spell.MaxRank {
+		return "", fmt.Errorf("invalid rank %d", rank)
+	}
+	resolved := tooltipPlaceholder.ReplaceAllStringFunc(spell.Tooltip, func(placeholder string) string {
+		key := tooltipPlaceholder.FindStringSubmatch(placeholder)[1]
+		if value, ok := spellVariable(spell, key, rank); ok {
+			return strconv.FormatFloat(value, 'f', -1, 64)
+		}
+		return placeholder
+	})
+	return resolved, nil
+}
+
+// spellVariable returns the value of the tooltip placeholder with the given key at the given rank
+func spellVariable(spell SpellData, key string, rank int) (float64, bool) {
+	if key == "cost" {
+		value, err := spellValueAtRank(spell.Cost, rank)
+		return value, err == nil
+	}
+	if len(key) > 1 && key[0] == 'e' {
+		if i, err := strconv.Atoi(key[1:]); err == nil {
+			if i < 0 || i >= len(spell.Effect) {
+				return 0, false
+			}
+			value, err := spellValueAtRank(spell.Effect[i], rank)
+			return value, err == nil
+		}
+	}
+	for _, v := range spell.Vars {
+		if v.Key == key {
+			return v.Coefficient, true
+		}
+	}
+	return 0, false
+}
+
 // PassiveData contains information about a champions passive ability
 type PassiveData struct {
 	Name        string    `json:"name"`
@@ -166,6 +315,8 @@ type Item struct {
 		Tier   int    `json:"tier"`
 		Type   string `json:"type"`
 	} `json:"rune"`
+	// Gold contains the cost of the item. Base is the cost of the item on top of the cost of its components, Total the
+	// cost including all components and Sell the gold received when selling the item.
 	Gold struct {
 		Base        int  `json:"base"`
 		Total       int  `json:"total"`
@@ -189,9 +340,24 @@ type Item struct {
 	Stats            ItemStats       `json:"stats"`
 	Tags             []string        `json:"tags"`
 	Maps             map[string]bool `json:"maps"`
+	Image            ImageData       `json:"image"`
 }
 
-// ItemStats contains information about the stats of an item
+// TypedStats returns the stats of the item with every known stat key of Data Dragon mapped to a field of ItemStats,
+// unknown stat keys are ignored
+func (i Item) TypedStats() ItemStats {
+	return i.Stats
+}
+
+// ItemTree represents the build path of an item
+type ItemTree struct {
+	Item       Item
+	Components []*ItemTree
+}
+
+// ItemStats contains information about the stats of an item. The fields are named after the stat keys of Data Dragon:
+// Flat stats are added to the stat, e.g. FlatHPPoolMod is bonus health, and Percent stats are fractions of the stat,
+// e.g. 0.1 for 10%. Stats with the prefix R are applied per level or as penetration.
 type ItemStats struct {
 	FlatHPPoolMod                       float64 `json:"FlatHPPoolMod"`
 	RFlatHPModPerLevel                  float64 `json:"rFlatHPModPerLevel"`
@@ -260,6 +426,77 @@ type ItemStats struct {
 	PercentSpellVampMod                 float64 `json:"PercentSpellVampMod"`
 }
 
+// Map returns all stats of the item by their stat key of Data Dragon, e.g. "FlatHPPoolMod"
+func (s ItemStats) Map() map[string]float64 {
+	return map[string]float64{
+		"FlatHPPoolMod":                       s.FlatHPPoolMod,
+		"rFlatHPModPerLevel":                  s.RFlatHPModPerLevel,
+		"FlatMPPoolMod":                       s.FlatMPPoolMod,
+		"rFlatMPModPerLevel":                  s.RFlatMPModPerLevel,
+		"PercentHPPoolMod":                    s.PercentHPPoolMod,
+		"PercentMPPoolMod":                    s.PercentMPPoolMod,
+		"FlatHPRegenMod":                      s.FlatHPRegenMod,
+		"rFlatHPRegenModPerLevel":             s.RFlatHPRegenModPerLevel,
+		"PercentHPRegenMod":                   s.PercentHPRegenMod,
+		"FlatMPRegenMod":                      s.FlatMPRegenMod,
+		"rFlatMPRegenModPerLevel":             s.RFlatMPRegenModPerLevel,
+		"PercentMPRegenMod":                   s.PercentMPRegenMod,
+		"FlatArmorMod":                        s.FlatArmorMod,
+		"rFlatArmorModPerLevel":               s.RFlatArmorModPerLevel,
+		"PercentArmorMod":                     s.PercentArmorMod,
+		"rFlatArmorPenetrationMod":            s.RFlatArmorPenetrationMod,
+		"rFlatArmorPenetrationModPerLevel":    s.RFlatArmorPenetrationModPerLevel,
+		"rPercentArmorPenetrationMod":         s.RPercentArmorPenetrationMod,
+		"rPercentArmorPenetrationModPerLevel": s.RPercentArmorPenetrationModPerLevel,
+		"FlatPhysicalDamageMod":               s.FlatPhysicalDamageMod,
+		"rFlatPhysicalDamageModPerLevel":      s.RFlatPhysicalDamageModPerLevel,
+		"PercentPhysicalDamageMod":            s.PercentPhysicalDamageMod,
+		"FlatMagicDamageMod":                  s.FlatMagicDamageMod,
+		"rFlatMagicDamageModPerLevel":         s.RFlatMagicDamageModPerLevel,
+		"PercentMagicDamageMod":               s.PercentMagicDamageMod,
+		"FlatMovementSpeedMod":                s.FlatMovementSpeedMod,
+		"rFlatMovementSpeedModPerLevel":       s.RFlatMovementSpeedModPerLevel,
+		"PercentMovementSpeedMod":             s.PercentMovementSpeedMod,
+		"rPercentMovementSpeedModPerLevel":    s.RPercentMovementSpeedModPerLevel,
+		"FlatAttackSpeedMod":                  s.FlatAttackSpeedMod,
+		"PercentAttackSpeedMod":               s.PercentAttackSpeedMod,
+		"rPercentAttackSpeedModPerLevel":      s.RPercentAttackSpeedModPerLevel,
+		"rFlatDodgeMod":                       s.RFlatDodgeMod,
+		"rFlatDodgeModPerLevel":               s.RFlatDodgeModPerLevel,
+		"PercentDodgeMod":                     s.PercentDodgeMod,
+		"FlatCritChanceMod":                   s.FlatCritChanceMod,
+		"rFlatCritChanceModPerLevel":          s.RFlatCritChanceModPerLevel,
+		"PercentCritChanceMod":                s.PercentCritChanceMod,
+		"FlatCritDamageMod":                   s.FlatCritDamageMod,
+		"rFlatCritDamageModPerLevel":          s.RFlatCritDamageModPerLevel,
+		"PercentCritDamageMod":                s.PercentCritDamageMod,
+		"FlatBlockMod":                        s.FlatBlockMod,
+		"PercentBlockMod":                     s.PercentBlockMod,
+		"FlatSpellBlockMod":                   s.FlatSpellBlockMod,
+		"rFlatSpellBlockModPerLevel":          s.RFlatSpellBlockModPerLevel,
+		"PercentSpellBlockMod":                s.PercentSpellBlockMod,
+		"FlatEXPBonus":                        s.FlatEXPBonus,
+		"PercentEXPBonus":                     s.PercentEXPBonus,
+		"rPercentCooldownMod":                 s.RPercentCooldownMod,
+		"rPercentCooldownModPerLevel":         s.RPercentCooldownModPerLevel,
+		"rFlatTimeDeadMod":                    s.RFlatTimeDeadMod,
+		"rFlatTimeDeadModPerLevel":            s.RFlatTimeDeadModPerLevel,
+		"rPercentTimeDeadMod":                 s.RPercentTimeDeadMod,
+		"rPercentTimeDeadModPerLevel":         s.RPercentTimeDeadModPerLevel,
+		"rFlatGoldPer10Mod":                   s.RFlatGoldPer10Mod,
+		"rFlatMagicPenetrationMod":            s.RFlatMagicPenetrationMod,
+		"rFlatMagicPenetrationModPerLevel":    s.RFlatMagicPenetrationModPerLevel,
+		"rPercentMagicPenetrationMod":         s.RPercentMagicPenetrationMod,
+		"rPercentMagicPenetrationModPerLevel": s.RPercentMagicPenetrationModPerLevel,
+		"FlatEnergyRegenMod":                  s.FlatEnergyRegenMod,
+		"rFlatEnergyRegenModPerLevel":         s.RFlatEnergyRegenModPerLevel,
+		"FlatEnergyPoolMod":                   s.FlatEnergyPoolMod,
+		"rFlatEnergyModPerLevel":              s.RFlatEnergyModPerLevel,
+		"PercentLifeStealMod":                 s.PercentLifeStealMod,
+		"PercentSpellVampMod":                 s.PercentSpellVampMod,
+	}
+}
+
 // Mastery represents an old mastery
 type Mastery struct {
 	ID           int       `json:"id"`
@@ -270,6 +507,37 @@ type Mastery struct {
 	Prerequisite string    `json:"prereq"`
 }
 
+// MasteryTree represents a tree of the legacy mastery system, e.g. Ferocity
+type MasteryTree struct {
+	Name string
+	// Tiers contains the masteries of each tier of the tree, starting with the first tier
+	Tiers [][]Mastery
+}
+
+// RuneReforgedPath represents a path of the Runes Reforged system, e.g. Precision or Domination
+type RuneReforgedPath struct {
+	ID    int                `json:"id"`
+	Key   string             `json:"key"`
+	Icon  string             `json:"icon"`
+	Name  string             `json:"name"`
+	Slots []RuneReforgedSlot `json:"slots"`
+}
+
+// RuneReforgedSlot represents a slot of a rune path from which one rune can be chosen
+type RuneReforgedSlot struct {
+	Runes []RuneReforged `json:"runes"`
+}
+
+// RuneReforged represents a rune of the Runes Reforged system
+type RuneReforged struct {
+	ID        int    `json:"id"`
+	Key       string `json:"key"`
+	Icon      string `json:"icon"`
+	Name      string `json:"name"`
+	ShortDesc string `json:"shortDesc"`
+	LongDesc  string `json:"longDesc"`
+}
+
 // ProfileIcon represents a profile icon
 type ProfileIcon struct {
 	ID    int       `json:"id"`
@@ -302,3 +570,100 @@ type SummonerSpell struct {
 	Image         ImageData `json:"image"`
 	Resource      string    `json:"resource"`
 }
+
+// GameMap represents a game map, e.g. Summoner's Rift or Howling Abyss
+type GameMap struct {
+	ID    int       `json:"MapId,string"`
+	Name  string    `json:"MapName"`
+	Image ImageData `json:"image"`
+}
+
+// Challenge represents a challenge which is progressed by playing games, e.g. to unlock titles
+type Challenge struct {
+	ID               int                           `json:"id"`
+	Name             string                        `json:"name"`
+	Description      string                        `json:"description"`
+	ShortDescription string                        `json:"shortDescription"`
+	HasLeaderboard   bool                          `json:"hasLeaderboard"`
+	LevelToIconPath  map[string]string             `json:"levelToIconPath"`
+	Thresholds       map[string]ChallengeThreshold `json:"thresholds"`
+}
+
+// ChallengeThreshold is the value required to reach a level of a challenge, e.g. "GOLD", and the rewards for it
+type ChallengeThreshold struct {
+	Value   float64           `json:"value"`
+	Rewards []ChallengeReward `json:"rewards"`
+}
+
+// ChallengeReward is a reward granted for reaching a level of a challenge. Category is e.g. "TITLE".
+type ChallengeReward struct {
+	Category string `json:"category"`
+	Quantity int    `json:"quantity"`
+	Title    string `json:"title"`
+}
+
+// TFTChampion represents a champion of Teamfight Tactics
+type TFTChampion struct {
+	ID    string    `json:"id"`
+	Name  string    `json:"name"`
+	Tier  int       `json:"tier"`
+	Image ImageData `json:"image"`
+}
+
+// TFTItem represents an item of Teamfight Tactics. Unlike items of the other game modes TFT items can not be bought.
+type TFTItem struct {
+	ID    string    `json:"id"`
+	Name  string    `json:"name"`
+	Image ImageData `json:"image"`
+}
+
+// TFTTrait represents a trait of Teamfight Tactics which grants bonuses if enough units with the trait are fielded
+type TFTTrait struct {
+	ID          string        `json:"id"`
+	Key         string        `json:"key"`
+	Name        string        `json:"name"`
+	Description string        `json:"description"`
+	Type        string        `json:"type"`
+	Sets        []TFTTraitSet `json:"sets"`
+	Image       ImageData     `json:"image"`
+}
+
+// TFTTraitSet represents a bonus threshold of a trait which is active if between Min and Max units with the trait are
+// fielded. Style is the tier of the threshold, e.g. "bronze", "silver" or "gold".
+type TFTTraitSet struct {
+	Style string `json:"style"`
+	Min   int    `json:"min"`
+	Max   int    `json:"max"`
+}
+
+// TFTAugment represents an augment of Teamfight Tactics. Tier is 1 for silver, 2 for gold and 3 for prismatic augments.
+type TFTAugment struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"desc"`
+	Tier        int       `json:"tier"`
+	Image       ImageData `json:"image"`
+}
+
+// TFTQueue represents a queue of Teamfight Tactics, e.g. normal or ranked games
+type TFTQueue struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	QueueType string    `json:"queueType"`
+	Image     ImageData `json:"image"`
+}
+
+// TFTRegalia represents the ranked emblem of a tier in a ranked queue of Teamfight Tactics, e.g. the tier "Gold" of
+// the queue "RANKED_TFT"
+type TFTRegalia struct {
+	Queue string    `json:"queue"`
+	Tier  string    `json:"tier"`
+	Image ImageData `json:"image"`
+}
+
+// TFTArena represents an arena skin of Teamfight Tactics
+type TFTArena struct {
+	ID    string    `json:"id"`
+	Name  string    `json:"name"`
+	Image ImageData `json:"image"`
+}
@@ -1,13 +1,26 @@
 package datadragon
 
 import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
 	"testing"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/KnutZuidema/golio/api"
 	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/internal/mock"
 )
 
 func TestChampionData_GetExtended(t *testing.T) {
@@ -65,3 +78,358 @@ func TestRecommendedItem_GetItem(t *testing.T) {
 		})
 	}
 }
+
+func TestItem_gold(t *testing.T) {
+	var item Item
+	require.Nil(t, json.Unmarshal([]byte(`{
+		"name": "Infinity Edge",
+		"from": ["1038", "1037", "1018"],
+		"gold": {"base": 625, "purchasable": true, "total": 3400, "sell": 2380},
+		"stats": {"FlatPhysicalDamageMod": 70, "FlatCritChanceMod": 0.2}
+	}`), &item))
+	assert.Equal(t, 625, item.Gold.Base)
+	assert.Equal(t, 3400, item.Gold.Total)
+	assert.Equal(t, 2380, item.Gold.Sell)
+	assert.True(t, item.Gold.Purchasable)
+	assert.Equal(t, 70.0, item.Stats.FlatPhysicalDamageMod)
+	assert.Equal(t, 0.2, item.Stats.FlatCritChanceMod)
+}
+
+func TestItem_TypedStats(t *testing.T) {
+	var item Item
+	require.Nil(t, json.Unmarshal([]byte(`{
+		"name": "Warmog's Armor",
+		"stats": {"FlatHPPoolMod": 800, "PercentHPRegenMod": 1, "rFlatGoldPer10Mod": 5, "UnknownStatMod": 1}
+	}`), &item))
+	assert.Equal(t, ItemStats{FlatHPPoolMod: 800, PercentHPRegenMod: 1, RFlatGoldPer10Mod: 5}, item.TypedStats())
+}
+
+func TestItemStats_Map(t *testing.T) {
+	// every stat has a distinct value, so a key which maps to the wrong field is detected
+	var stats ItemStats
+	value := reflect.ValueOf(&stats).Elem()
+	for i := 0; i < value.NumField(); i++ {
+		value.Field(i).SetFloat(float64(i + 1))
+	}
+	encoded, err := json.Marshal(stats)
+	require.Nil(t, err)
+	var want map[string]float64
+	require.Nil(t, json.Unmarshal(encoded, &want))
+	assert.Equal(t, want, stats.Map())
+}
+
+func TestChampionDataStats_AtLevel(t *testing.T) {
+	stats := ChampionDataStats{
+		HealthPoints:         580,
+		HealthPointsPerLevel: 90,
+		Armor:                38,
+		ArmorPerLevel:        3.25,
+		AttackDamage:         60,
+		AttackDamagePerLevel: 5,
+		AttackSpeedPerLevel:  2.5,
+		MovementSpeed:        345,
+		AttackRange:          175,
+	}
+	type test struct {
+		name  string
+		level int
+		want  ChampionStats
+	}
+	tests := []test{
+		{
+			name:  "level 1",
+			level: 1,
+			want: ChampionStats{
+				HealthPoints:  580,
+				Armor:         38,
+				AttackDamage:  60,
+				MovementSpeed: 345,
+				AttackRange:   175,
+			},
+		},
+		{
+			name:  "level 2",
+			level: 2,
+			want: ChampionStats{
+				HealthPoints:     580 + 90*0.72,
+				Armor:            38 + 3.25*0.72,
+				AttackDamage:     60 + 5*0.72,
+				BonusAttackSpeed: 2.5 * 0.72,
+				MovementSpeed:    345,
+				AttackRange:      175,
+			},
+		},
+		{
+			name:  "level 18",
+			level: 18,
+			want: ChampionStats{
+				HealthPoints:     580 + 90*17,
+				Armor:            38 + 3.25*17,
+				AttackDamage:     60 + 5*17,
+				BonusAttackSpeed: 2.5 * 17,
+				MovementSpeed:    345,
+				AttackRange:      175,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := stats.AtLevel(test.level)
+			assert.InDelta(t, test.want.HealthPoints, got.HealthPoints, 1e-9)
+			assert.InDelta(t, test.want.Armor, got.Armor, 1e-9)
+			assert.InDelta(t, test.want.AttackDamage, got.AttackDamage, 1e-9)
+			assert.InDelta(t, test.want.BonusAttackSpeed, got.BonusAttackSpeed, 1e-9)
+			assert.Equal(t, test.want.MovementSpeed, got.MovementSpeed)
+			assert.Equal(t, test.want.AttackRange, got.AttackRange)
+		})
+	}
+}
+
+func TestSpellAtRank(t *testing.T) {
+	spell := SpellData{
+		MaxRank:  5,
+		Cooldown: []float64{14, 12, 10, 8, 6},
+		Cost:     []float64{0, 0, 0, 0, 0},
+		Range:    []float64{625, 625, 625, 625, 625},
+	}
+	type test struct {
+		name    string
+		value   func(SpellData, int) (float64, error)
+		rank    int
+		want    float64
+		wantErr error
+	}
+	tests := []test{
+		{
+			name:  "cooldown at rank 1",
+			value: SpellCooldownAtRank,
+			rank:  1,
+			want:  14,
+		},
+		{
+			name:  "cooldown at max rank",
+			value: SpellCooldownAtRank,
+			rank:  5,
+			want:  6,
+		},
+		{
+			name:  "cost",
+			value: SpellCostAtRank,
+			rank:  3,
+			want:  0,
+		},
+		{
+			name:  "range",
+			value: SpellRangeAtRank,
+			rank:  2,
+			want:  625,
+		},
+		{
+			name:    "rank too low",
+			value:   SpellCooldownAtRank,
+			rank:    0,
+			wantErr: fmt.Errorf("invalid rank 0"),
+		},
+		{
+			name:    "rank too high",
+			value:   SpellRangeAtRank,
+			rank:    6,
+			wantErr: fmt.Errorf("invalid rank 6"),
+		},
+		{
+			name:    "no values",
+			value:   func(spell SpellData, rank int) (float64, error) { return SpellCostAtRank(SpellData{}, rank) },
+			rank:    1,
+			wantErr: fmt.Errorf("invalid rank 1"),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.value(spell, test.rank)
+			assert.Equal(t, test.wantErr, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestParseBurn(t *testing.T) {
+	tests := []struct {
+		name    string
+		burn    string
+		want    []float64
+		wantErr bool
+	}{
+		{name: "ranks", burn: "16/14/12/10/8", want: []float64{16, 14, 12, 10, 8}},
+		{name: "single value", burn: "300", want: []float64{300}},
+		{name: "decimals", burn: "0.5/0.75/1", want: []float64{0.5, 0.75, 1}},
+		{name: "non-breaking space", burn: "60&nbsp;/ 70&nbsp;/&nbsp;80", want: []float64{60, 70, 80}},
+		{name: "empty", burn: ""},
+		{name: "self", burn: "self", wantErr: true},
+		{name: "missing value", burn: "1//3", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseBurn(tt.burn)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSpellData_BurnValues(t *testing.T) {
+	spell := SpellData{CooldownBurn: "14/12/10", CostBurn: "0", RangeBurn: "self"}
+	cooldown, err := spell.CooldownBurnValues()
+	require.Nil(t, err)
+	assert.Equal(t, []float64{14, 12, 10}, cooldown)
+	cost, err := spell.CostBurnValues()
+	require.Nil(t, err)
+	assert.Equal(t, []float64{0}, cost)
+	_, err = spell.RangeBurnValues()
+	assert.NotNil(t, err)
+}
+
+func TestResolveSpellTooltip(t *testing.T) {
+	var spell SpellData
+	require.Nil(t, json.Unmarshal([]byte(`{
+		"tooltip": "Deals {{ e1 }} magic damage (+{{ a1 }} ability power) for {{cost}} mana.{{ modifier }}",
+		"maxrank": 3,
+		"cost": [50, 55, 60],
+		"effect": [null, [40, 65, 90]],
+		"vars": [{"link": "spelldamage", "coeff": 0.45, "key": "a1"}]
+	}`), &spell))
+	type test struct {
+		name    string
+		spell   SpellData
+		rank    int
+		want    string
+		wantErr error
+	}
+	tests := []test{
+		{
+			name:  "rank 1",
+			spell: spell,
+			rank:  1,
+			want:  "Deals 40 magic damage (+0.45 ability power) for 50 mana.{{ modifier }}",
+		},
+		{
+			name:  "max rank",
+			spell: spell,
+			rank:  3,
+			want:  "Deals 90 magic damage (+0.45 ability power) for 60 mana.{{ modifier }}",
+		},
+		{
+			name:  "unknown effect",
+			spell: SpellData{Tooltip: "{{ e5 }}", MaxRank: 1},
+			rank:  1,
+			want:  "{{ e5 }}",
+		},
+		{
+			name:    "invalid rank",
+			spell:   spell,
+			rank:    4,
+			wantErr: fmt.Errorf("invalid rank 4"),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ResolveSpellTooltip(test.spell, test.rank)
+			assert.Equal(t, test.wantErr, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+var update = flag.Bool("update", false, "update golden files")
+
+// testdataDoer responds with the files in testdata/data for data file requests and with 404 for all other requests
+func testdataDoer() internal.Doer {
+	return &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			i := strings.Index(r.URL.Path, "/data/en_US/")
+			if i < 0 {
+				return &http.Response{StatusCode: http.StatusNotFound}, nil
+			}
+			content, err := ioutil.ReadFile(filepath.Join("testdata", "data", r.URL.Path[i+len("/data/en_US/"):]))
+			if os.IsNotExist(err) {
+				return &http.Response{StatusCode: http.StatusNotFound}, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			return &http.Response{StatusCode: http.StatusOK, Body: &mock.ResponseBody{Content: content}}, nil
+		},
+	}
+}
+
+// TestModel_golden decodes captured Data Dragon responses from testdata/data and compares the resulting models with
+// the golden files in testdata/golden. Run the tests with -update to rewrite the golden files after changing a model.
+func TestModel_golden(t *testing.T) {
+	c := NewClient(testdataDoer(), api.RegionEuropeWest, log.StandardLogger())
+	tests := []struct {
+		name string
+		get  func() (interface{}, error)
+	}{
+		{
+			name: "champions",
+			get: func() (interface{}, error) {
+				champions, err := c.GetChampions()
+				sort.Slice(champions, func(i, j int) bool { return champions[i].ID < champions[j].ID })
+				return champions, err
+			},
+		},
+		{
+			name: "champion",
+			get: func() (interface{}, error) {
+				return c.GetChampion("Aatrox")
+			},
+		},
+		{
+			name: "item",
+			get: func() (interface{}, error) {
+				return c.GetItem("1001")
+			},
+		},
+		{
+			name: "summoner_spell",
+			get: func() (interface{}, error) {
+				return c.GetSummonerSpell("SummonerFlash")
+			},
+		},
+		{
+			name: "profile_icon",
+			get: func() (interface{}, error) {
+				return c.GetProfileIcon(588)
+			},
+		},
+		{
+			name: "reforged_runes",
+			get: func() (interface{}, error) {
+				return c.GetReforgedRunes()
+			},
+		},
+		{
+			name: "map",
+			get: func() (interface{}, error) {
+				return c.GetMap(11)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.get()
+			require.Nil(t, err)
+			var content bytes.Buffer
+			encoder := json.NewEncoder(&content)
+			encoder.SetEscapeHTML(false)
+			encoder.SetIndent("", "  ")
+			require.Nil(t, encoder.Encode(got))
+			golden := filepath.Join("testdata", "golden", test.name+".json")
+			if *update {
+				require.Nil(t, ioutil.WriteFile(golden, content.Bytes(), 0644))
+			}
+			want, err := ioutil.ReadFile(golden)
+			require.Nil(t, err)
+			assert.JSONEq(t, string(want), content.String())
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package datadragon
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	matchExact = iota
+	matchPrefix
+	matchContains
+	noMatch
+)
+
+// SearchChampion returns all champions whose name or id matches the given query, e.g. "kaisa" for Kai'Sa or "wukong"
+// and "monkeyking" for Wukong. Case, spaces and punctuation are ignored. Exact matches are returned first, followed by
+// champions whose name or id starts with the query and finally champions whose name or id contains the query.
+func (c *Client) SearchChampion(query string) ([]ChampionData, error) {
+	return c.SearchChampionCtx(context.Background(), query)
+}
+
+// SearchChampionCtx is like SearchChampion but uses the given context for all requests
+func (c *Client) SearchChampionCtx(ctx context.Context, query string) ([]ChampionData, error) {
+	champions, err := c.GetChampionsCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query = normalizeName(query)
+	if query == "" {
+		return []ChampionData{}, nil
+	}
+	ranks := map[string]int{}
+	res := make([]ChampionData, 0)
+	for _, champion := range champions {
+		rank := matchRank(query, normalizeName(champion.Name))
+		if idRank := matchRank(query, normalizeName(champion.ID)); idRank < rank {
+			rank = idRank
+		}
+		if rank == noMatch {
+			continue
+		}
+		ranks[champion.Name] = rank
+		res = append(res, champion)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if ranks[res[i].Name] != ranks[res[j].Name] {
+			return ranks[res[i].Name] < ranks[res[j].Name]
+		}
+		return res[i].Name < res[j].Name
+	})
+	return res, nil
+}
+
+// ChampionsByTag returns all champions with the given tag, e.g. "Mage", sorted by name. Tags are compared
+// case-insensitively. Tags are the only classification of champions provided by Data Dragon, it contains no
+// information about the positions or lanes champions are played in.
+func (c *Client) ChampionsByTag(tag string) ([]ChampionData, error) {
+	return c.ChampionsByTagCtx(context.Background(), tag)
+}
+
+// ChampionsByTagCtx is like ChampionsByTag but uses the given context for all requests
+func (c *Client) ChampionsByTagCtx(ctx context.Context, tag string) ([]ChampionData, error) {
+	champions, err := c.GetChampionsCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := []ChampionData{}
+	for _, champion := range champions {
+		if containsFold(champion.Tags, tag) {
+			res = append(res, champion)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Name < res[j].Name
+	})
+	return res, nil
+}
+
+// matchRank returns how well the normalized query matches the normalized value
+func matchRank(query, value string) int {
+	switch {
+	case value == query:
+		return matchExact
+	case strings.HasPrefix(value, query):
+		return matchPrefix
+	case strings.Contains(value, query):
+		return matchContains
+	default:
+		return noMatch
+	}
+}
+
+// normalizeName converts the name to lower case and removes everything but letters and digits
+func normalizeName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, name)
+}
+
+// FindItems returns all items for which the predicate returns true
+func (c *Client) FindItems(predicate func(Item) bool) ([]Item, error) {
+	return c.FindItemsCtx(context.Background(), predicate)
+}
+
+// FindItemsCtx is like FindItems but uses the given context for all requests
+func (c *Client) FindItemsCtx(ctx context.Context, predicate func(Item) bool) ([]Item, error) {
+	items, err := c.GetItemsCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := []Item{}
+	for _, item := range items {
+		if predicate(item) {
+			res = append(res, item)
+		}
+	}
+	return res, nil
+}
+
+// FindItemsByTag returns all items with the given tag, e.g. "Boots". Tags are compared case-insensitively.
+func (c *Client) FindItemsByTag(tag string) ([]Item, error) {
+	return c.FindItemsByTagCtx(context.Background(), tag)
+}
+
+// FindItemsByTagCtx is like FindItemsByTag but uses the given context for all requests
+func (c *Client) FindItemsByTagCtx(ctx context.Context, tag string) ([]Item, error) {
+	return c.FindItemsCtx(ctx, func(item Item) bool {
+		return containsFold(item.Tags, tag)
+	})
+}
+
+// GetItemsForMap returns all items which are available on the map with the given id, e.g. "11" for Summoner's Rift or
+// "12" for Howling Abyss
+func (c *Client) GetItemsForMap(mapID string) ([]Item, error) {
+	return c.GetItemsForMapCtx(context.Background(), mapID)
+}
+
+// GetItemsForMapCtx is like GetItemsForMap but uses the given context for all requests
+func (c *Client) GetItemsForMapCtx(ctx context.Context, mapID string) ([]Item, error) {
+	return c.FindItemsCtx(ctx, func(item Item) bool {
+		return item.Maps[mapID]
+	})
+}
+
+// GetPurchasableItems returns all items which can be bought in the store. Items which are not listed in the store or
+// can only be obtained otherwise, e.g. by upgrading another item, are excluded.
+func (c *Client) GetPurchasableItems() ([]Item, error) {
+	return c.GetPurchasableItemsCtx(context.Background())
+}
+
+// GetPurchasableItemsCtx is like GetPurchasableItems but uses the given context for all requests
+func (c *Client) GetPurchasableItemsCtx(ctx context.Context) ([]Item, error) {
+	return c.FindItemsCtx(ctx, func(item Item) bool {
+		return item.InStore && item.Gold.Purchasable
+	})
+}
+
+// FindItemsBuildingInto returns all items which are components of the item with the given id
+func (c *Client) FindItemsBuildingInto(id string) ([]Item, error) {
+	return c.FindItemsBuildingIntoCtx(context.Background(), id)
+}
+
+// FindItemsBuildingIntoCtx is like FindItemsBuildingInto but uses the given context for all requests
+func (c *Client) FindItemsBuildingIntoCtx(ctx context.Context, id string) ([]Item, error) {
+	return c.FindItemsCtx(ctx, func(item Item) bool {
+		for _, into := range item.Into {
+			if into == id {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// containsFold reports whether values contains value under case-insensitive comparison
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,251 @@
+package datadragon
+
+import (
+	"net/http"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+func TestClient_SearchChampion(t *testing.T) {
+	t.Parallel()
+	doer := dataDragonResponseDoer(map[string]ChampionData{
+		"Kaisa":      {ID: "Kaisa", Name: "Kai'Sa"},
+		"Kayle":      {ID: "Kayle", Name: "Kayle"},
+		"Kayn":       {ID: "Kayn", Name: "Kayn"},
+		"MonkeyKing": {ID: "MonkeyKing", Name: "Wukong"},
+		"DrMundo":    {ID: "DrMundo", Name: "Dr. Mundo"},
+		"Nunu":       {ID: "Nunu", Name: "Nunu & Willump"},
+	})
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{
+			name:  "apostrophe",
+			query: "kaisa",
+			want:  []string{"Kai'Sa"},
+		},
+		{
+			name:  "display name",
+			query: "Wukong",
+			want:  []string{"Wukong"},
+		},
+		{
+			name:  "id",
+			query: "monkeyking",
+			want:  []string{"Wukong"},
+		},
+		{
+			name:  "punctuation and spaces",
+			query: "dr mundo",
+			want:  []string{"Dr. Mundo"},
+		},
+		{
+			name:  "ranked",
+			query: "kay",
+			want:  []string{"Kayle", "Kayn"},
+		},
+		{
+			name:  "exact before prefix",
+			query: "kayn",
+			want:  []string{"Kayn"},
+		},
+		{
+			name:  "contains",
+			query: "willump",
+			want:  []string{"Nunu & Willump"},
+		},
+		{
+			name:  "no match",
+			query: "teemo",
+			want:  []string{},
+		},
+		{
+			name:  "empty query",
+			query: "'",
+			want:  []string{},
+		},
+	}
+	c := NewClient(doer, api.RegionEuropeWest, log.StandardLogger())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.SearchChampion(tt.query)
+			assert.Nil(t, err)
+			names := make([]string, 0, len(got))
+			for _, champion := range got {
+				names = append(names, champion.Name)
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
+
+func TestClient_SearchChampion_error(t *testing.T) {
+	t.Parallel()
+	c := NewClient(mock.NewStatusMockDoer(http.StatusForbidden), api.RegionEuropeWest, log.StandardLogger())
+	got, err := c.SearchChampion("kaisa")
+	assert.Equal(t, api.ErrForbidden, err)
+	assert.Nil(t, got)
+}
+
+func TestClient_ChampionsByTag(t *testing.T) {
+	t.Parallel()
+	doer := dataDragonResponseDoer(map[string]ChampionData{
+		"Annie":  {ID: "Annie", Name: "Annie", Tags: []string{"Mage"}},
+		"Ahri":   {ID: "Ahri", Name: "Ahri", Tags: []string{"Mage", "Assassin"}},
+		"Garen":  {ID: "Garen", Name: "Garen", Tags: []string{"Fighter", "Tank"}},
+		"Zed":    {ID: "Zed", Name: "Zed", Tags: []string{"Assassin"}},
+		"Rammus": {ID: "Rammus", Name: "Rammus"},
+	})
+	c := NewClient(doer, api.RegionEuropeWest, log.StandardLogger())
+	tests := []struct {
+		name string
+		tag  string
+		want []string
+	}{
+		{
+			name: "single tag",
+			tag:  "Fighter",
+			want: []string{"Garen"},
+		},
+		{
+			name: "multiple champions",
+			tag:  "Mage",
+			want: []string{"Ahri", "Annie"},
+		},
+		{
+			name: "case insensitive",
+			tag:  "assassin",
+			want: []string{"Ahri", "Zed"},
+		},
+		{
+			name: "unknown tag",
+			tag:  "Support",
+			want: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.ChampionsByTag(tt.tag)
+			assert.Nil(t, err)
+			names := make([]string, 0, len(got))
+			for _, champion := range got {
+				names = append(names, champion.Name)
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
+
+func TestClient_FindItems(t *testing.T) {
+	t.Parallel()
+	doer := dataDragonResponseDoer(map[string]Item{
+		"1001": {
+			Name: "Boots",
+			Tags: []string{"Boots"},
+			Into: []string{"3006", "3047"},
+			Maps: map[string]bool{"11": true, "12": true},
+		},
+		"3006": {
+			Name: "Berserker's Greaves",
+			Tags: []string{"Boots", "AttackSpeed"},
+			Maps: map[string]bool{"11": true},
+		},
+		"1036": {
+			Name: "Long Sword",
+			Tags: []string{"Damage"},
+			Into: []string{"3031"},
+			Maps: map[string]bool{"11": false, "12": true},
+		},
+	})
+	c := NewClient(doer, api.RegionEuropeWest, log.StandardLogger())
+	tests := []struct {
+		name string
+		find func() ([]Item, error)
+		want []string
+	}{
+		{
+			name: "predicate",
+			find: func() ([]Item, error) {
+				return c.FindItems(func(item Item) bool { return len(item.Into) > 0 })
+			},
+			want: []string{"1001", "1036"},
+		},
+		{
+			name: "by tag",
+			find: func() ([]Item, error) { return c.FindItemsByTag("boots") },
+			want: []string{"1001", "3006"},
+		},
+		{
+			name: "by unknown tag",
+			find: func() ([]Item, error) { return c.FindItemsByTag("Jungle") },
+			want: []string{},
+		},
+		{
+			name: "building into",
+			find: func() ([]Item, error) { return c.FindItemsBuildingInto("3047") },
+			want: []string{"1001"},
+		},
+		{
+			name: "for map",
+			find: func() ([]Item, error) { return c.GetItemsForMap("12") },
+			want: []string{"1001", "1036"},
+		},
+		{
+			name: "for unknown map",
+			find: func() ([]Item, error) { return c.GetItemsForMap("30") },
+			want: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.find()
+			assert.Nil(t, err)
+			ids := make([]string, 0, len(got))
+			for _, item := range got {
+				ids = append(ids, item.ID)
+			}
+			assert.ElementsMatch(t, tt.want, ids)
+		})
+	}
+}
+
+func TestClient_GetPurchasableItems(t *testing.T) {
+	t.Parallel()
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body: &mock.ResponseBody{Content: []byte(`{"data":{
+					"1001":{"name":"Boots","gold":{"purchasable":true}},
+					"3040":{"name":"Seraph's Embrace","gold":{"purchasable":false}},
+					"7000":{"name":"Sandshrike's Claw","inStore":false,"gold":{"purchasable":true}},
+					"1036":{"name":"Long Sword","inStore":true,"gold":{"purchasable":true}}
+				}}`)},
+			}, nil
+		},
+	}
+	c := NewClient(doer, api.RegionEuropeWest, log.StandardLogger())
+	got, err := c.GetPurchasableItems()
+	require.Nil(t, err)
+	ids := make([]string, 0, len(got))
+	for _, item := range got {
+		ids = append(ids, item.ID)
+	}
+	assert.ElementsMatch(t, []string{"1001", "1036"}, ids)
+}
+
+func TestClient_FindItems_error(t *testing.T) {
+	t.Parallel()
+	c := NewClient(mock.NewStatusMockDoer(http.StatusForbidden), api.RegionEuropeWest, log.StandardLogger())
+	got, err := c.FindItemsByTag("Boots")
+	assert.Equal(t, api.ErrForbidden, err)
+	assert.Nil(t, got)
+}
@@ -0,0 +1,147 @@
+package datadragon
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// NewClientFromTarball returns a new client which serves all data from the Data Dragon archive
+// (dragontail-<version>.tgz) at the given path instead of requesting it from the Data Dragon service. Only the data
+// files of the given language are read from the archive, images are not available. The caches of all data files
+// loaded by Preload are populated before the client is returned, data files missing from the archive are skipped,
+// e.g. runesReforged.json in archives older than 7.22.1. If logger is nil logging is disabled.
+func NewClientFromTarball(path string, language languageCode, logger log.FieldLogger,
+	options ...Option) (*Client, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	version, files, err := readTarball(file, language)
+	if err != nil {
+		return nil, err
+	}
+	doer := &tarballDoer{files: files}
+	c := newClient(doer, logger, options...)
+	if c.baseURL != "" {
+		baseURL, err := url.Parse(c.baseURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid base URL %s: %w", c.baseURL, err)
+		}
+		doer.basePath = baseURL.Path
+	}
+	c.version = version
+	c.language = language
+	c.initialized = true
+	c.versionUpdated = time.Now()
+	for _, loader := range tarballLoaders {
+		content, ok := files[version+"/data/"+string(language)+"/"+loader.file]
+		if !ok {
+			continue
+		}
+		if err := loader.load(c, bytes.NewReader(content)); err != nil {
+			return nil, fmt.Errorf("could not load %s: %w", loader.file, err)
+		}
+	}
+	return c, nil
+}
+
+// tarballLoaders are the data files which are loaded by NewClientFromTarball, they match the data loaded by Preload
+var tarballLoaders = []struct {
+	file string
+	load func(c *Client, r io.Reader) error
+}{
+	{file: "champion.json", load: (*Client).LoadChampionsFromReader},
+	{file: "item.json", load: (*Client).LoadItemsFromReader},
+	{file: "summoner.json", load: (*Client).LoadSummonerSpellsFromReader},
+	{file: "profileicon.json", load: (*Client).LoadProfileIconsFromReader},
+	{file: "runesReforged.json", load: (*Client).LoadReforgedRunesFromReader},
+}
+
+// readTarball reads all data files of the given language from the gzipped tar archive. The files are returned by
+// their path relative to the cdn directory of the Data Dragon service, e.g. "9.10.1/data/en_US/champion.json".
+func readTarball(r io.Reader, language languageCode) (string, map[string][]byte, error) {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return "", nil, err
+	}
+	defer gzipReader.Close()
+	tarReader := tar.NewReader(gzipReader)
+	var version string
+	files := map[string][]byte{}
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", nil, err
+		}
+		fileVersion, name, ok := tarballDataFile(header, language)
+		if !ok {
+			continue
+		}
+		if version != "" && version != fileVersion {
+			return "", nil, fmt.Errorf("archive contains data of versions %s and %s", version, fileVersion)
+		}
+		version = fileVersion
+		content, err := ioutil.ReadAll(tarReader)
+		if err != nil {
+			return "", nil, err
+		}
+		files[name] = content
+	}
+	if version == "" {
+		return "", nil, fmt.Errorf("archive contains no data for language %s", language)
+	}
+	return version, files, nil
+}
+
+// tarballDataFile returns the version and the path relative to the cdn directory of the entry of a Data Dragon archive
+// if it is a data file of the given language. Data files are located at <version>/data/<language>/..., possibly below
+// a common root directory.
+func tarballDataFile(header *tar.Header, language languageCode) (string, string, bool) {
+	if header.Typeflag != tar.TypeReg {
+		return "", "", false
+	}
+	split := strings.Split(path.Clean(header.Name), "/")
+	for i := 0; i+3 < len(split); i++ {
+		if split[i+1] == "data" && split[i+2] == string(language) && isValidVersion(split[i]) {
+			return split[i], strings.Join(split[i:], "/"), true
+		}
+	}
+	return "", "", false
+}
+
+// tarballDoer answers requests to the Data Dragon service with the files read from a Data Dragon archive
+type tarballDoer struct {
+	files map[string][]byte
+	// basePath is the path of the base URL set with WithBaseURL, which precedes the cdn directory in all requests
+	basePath string
+}
+
+func (d *tarballDoer) Do(r *http.Request) (*http.Response, error) {
+	content, ok := d.files[strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, d.basePath), "/cdn/")]
+	if !ok {
+		return &http.Response{
+			StatusCode: http.StatusNotFound,
+			Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+		}, nil
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       ioutil.NopCloser(bytes.NewReader(content)),
+	}, nil
+}
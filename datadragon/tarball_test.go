@@ -0,0 +1,178 @@
+package datadragon
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+)
+
+func TestNewClientFromTarball(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "golio")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	files := map[string]interface{}{
+		"9.10.1/data/en_US/champion.json": dataDragonResponse{Data: map[string]ChampionData{
+			"Aatrox": {ID: "Aatrox", Key: "266", Name: "Aatrox"},
+		}},
+		"9.10.1/data/en_US/champion/Aatrox.json": dataDragonResponse{Data: map[string]ChampionDataExtended{
+			"Aatrox": {ChampionData: ChampionData{ID: "Aatrox", Key: "266", Name: "Aatrox"}, Lore: "lore"},
+		}},
+		"9.10.1/data/de_DE/champion/Aatrox.json": dataDragonResponse{Data: map[string]ChampionDataExtended{
+			"Aatrox": {ChampionData: ChampionData{ID: "Aatrox", Key: "266", Name: "Aatrox"}, Lore: "Hintergrund"},
+		}},
+		"9.10.1/data/en_US/item.json": dataDragonResponse{Data: map[string]Item{"1001": {Name: "Boots"}}},
+		"9.10.1/data/en_US/summoner.json": dataDragonResponse{Data: map[string]SummonerSpell{
+			"SummonerFlash": {ID: "SummonerFlash"},
+		}},
+		"9.10.1/data/en_US/profileicon.json":   dataDragonResponse{Data: map[string]ProfileIcon{"1": {ID: 1}}},
+		"9.10.1/data/en_US/runesReforged.json": []RuneReforgedPath{{ID: 8000, Key: "Precision"}},
+	}
+	path := filepath.Join(dir, "dragontail-9.10.1.tgz")
+	writeTestTarball(t, path, files)
+
+	c, err := NewClientFromTarball(path, LanguageCodeUnitedStates, log.StandardLogger())
+	require.Nil(t, err)
+	assert.Equal(t, "9.10.1", c.version)
+	assert.Equal(t, languageCode(LanguageCodeUnitedStates), c.language)
+	assert.Equal(t, CacheStats{
+		Version:        "9.10.1",
+		Language:       LanguageCodeUnitedStates,
+		Champions:      1,
+		Items:          1,
+		SummonerSpells: 1,
+		ProfileIcons:   1,
+	}, c.CacheStats())
+	champion, err := c.GetChampion("Aatrox")
+	require.Nil(t, err)
+	assert.Equal(t, "lore", champion.Lore)
+	item, err := c.GetItem("1001")
+	require.Nil(t, err)
+	assert.Equal(t, "Boots", item.Name)
+	_, err = c.GetMaps()
+	assert.Equal(t, api.ErrNotFound, err)
+}
+
+func TestNewClientFromTarball_baseURL(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "golio")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "dragontail-9.10.1.tgz")
+	writeTestTarball(t, path, map[string]interface{}{
+		"9.10.1/data/en_US/champion.json": dataDragonResponse{Data: map[string]ChampionData{
+			"Aatrox": {ID: "Aatrox", Key: "266", Name: "Aatrox"},
+		}},
+		"9.10.1/data/en_US/champion/Aatrox.json": dataDragonResponse{Data: map[string]ChampionDataExtended{
+			"Aatrox": {ChampionData: ChampionData{ID: "Aatrox", Key: "266", Name: "Aatrox"}, Lore: "lore"},
+		}},
+	})
+
+	c, err := NewClientFromTarball(path, LanguageCodeUnitedStates, log.StandardLogger(),
+		WithBaseURL("https://example.com/ddragon/"))
+	require.Nil(t, err)
+	champion, err := c.GetChampion("Aatrox")
+	require.Nil(t, err)
+	assert.Equal(t, "lore", champion.Lore)
+}
+
+func TestNewClientFromTarball_missingDataFiles(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "golio")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	// archives older than 7.22.1 contain no runesReforged.json
+	files := map[string]interface{}{
+		"7.10.1/data/en_US/champion.json": dataDragonResponse{Data: map[string]ChampionData{
+			"Aatrox": {ID: "Aatrox", Key: "266", Name: "Aatrox"},
+		}},
+		"7.10.1/data/en_US/item.json": dataDragonResponse{Data: map[string]Item{"1001": {Name: "Boots"}}},
+	}
+	path := filepath.Join(dir, "dragontail-7.10.1.tgz")
+	writeTestTarball(t, path, files)
+
+	c, err := NewClientFromTarball(path, LanguageCodeUnitedStates, nil)
+	require.Nil(t, err)
+	assert.Equal(t, CacheStats{
+		Version:   "7.10.1",
+		Language:  LanguageCodeUnitedStates,
+		Champions: 1,
+		Items:     1,
+	}, c.CacheStats())
+	_, err = c.GetReforgedRunes()
+	assert.Equal(t, api.ErrNotFound, err)
+}
+
+func TestNewClientFromTarball_error(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "golio")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	tests := []struct {
+		name  string
+		files map[string]interface{}
+	}{
+		{
+			name: "no data for language",
+			files: map[string]interface{}{
+				"9.10.1/data/de_DE/champion.json": dataDragonResponse{},
+			},
+		},
+		{
+			name: "multiple versions",
+			files: map[string]interface{}{
+				"9.10.1/data/en_US/champion.json": dataDragonResponse{},
+				"9.11.1/data/en_US/champion.json": dataDragonResponse{},
+			},
+		},
+		{
+			name: "invalid data file",
+			files: map[string]interface{}{
+				"9.10.1/data/en_US/champion.json": "champions",
+			},
+		},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(dir, string(rune('a'+i))+".tgz")
+			writeTestTarball(t, path, tt.files)
+			c, err := NewClientFromTarball(path, LanguageCodeUnitedStates, log.StandardLogger())
+			assert.NotNil(t, err)
+			assert.Nil(t, c)
+		})
+	}
+	_, err = NewClientFromTarball(filepath.Join(dir, "missing.tgz"), LanguageCodeUnitedStates, nil)
+	assert.NotNil(t, err)
+}
+
+func writeTestTarball(t *testing.T, path string, files map[string]interface{}) {
+	file, err := os.Create(path)
+	require.Nil(t, err)
+	defer file.Close()
+	gzipWriter := gzip.NewWriter(file)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for name, object := range files {
+		content, err := json.Marshal(object)
+		require.Nil(t, err)
+		require.Nil(t, tarWriter.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0644,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		}))
+		_, err = tarWriter.Write(content)
+		require.Nil(t, err)
+	}
+	require.Nil(t, tarWriter.Close())
+	require.Nil(t, gzipWriter.Close())
+}
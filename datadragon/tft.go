@@ -0,0 +1,261 @@
+package datadragon
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// GetTFTChampions returns all existing Teamfight Tactics champions
+func (c *Client) GetTFTChampions() ([]TFTChampion, error) {
+	return c.GetTFTChampionsCtx(context.Background())
+}
+
+// GetTFTChampionsCtx is like GetTFTChampions but uses the given context for all requests
+func (c *Client) GetTFTChampionsCtx(ctx context.Context) ([]TFTChampion, error) {
+	var champions map[string]TFTChampion
+	var res []TFTChampion
+	err := c.getTFTData(ctx, "/tft-champion.json", &champions, &c.tftChampionsMu, &c.tftChampionsUpdated,
+		func() bool { return len(c.tftChampions) > 0 },
+		func() {
+			c.tftChampions = make([]TFTChampion, 0, len(champions))
+			for _, champion := range champions {
+				c.tftChampions = append(c.tftChampions, champion)
+			}
+		},
+		func() { res = append([]TFTChampion{}, c.tftChampions...) })
+	return res, err
+}
+
+// GetTFTChampionsForSet returns all Teamfight Tactics champions of the set with the given number. The set of a
+// champion is taken from its id, e.g. "TFT9_Ahri" belongs to set 9.
+func (c *Client) GetTFTChampionsForSet(set int) ([]TFTChampion, error) {
+	return c.GetTFTChampionsForSetCtx(context.Background(), set)
+}
+
+// GetTFTChampionsForSetCtx is like GetTFTChampionsForSet but uses the given context for all requests
+func (c *Client) GetTFTChampionsForSetCtx(ctx context.Context, set int) ([]TFTChampion, error) {
+	champions, err := c.GetTFTChampionsCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := []TFTChampion{}
+	for _, champion := range champions {
+		if championSet, ok := tftSet(champion.ID); ok && championSet == set {
+			res = append(res, champion)
+		}
+	}
+	return res, nil
+}
+
+// tftSet returns the set number of the given TFT id of the form "TFT<set>_<name>"
+func tftSet(id string) (int, bool) {
+	if !strings.HasPrefix(id, "TFT") {
+		return 0, false
+	}
+	digits := id[len("TFT"):]
+	end := strings.IndexFunc(digits, func(r rune) bool { return r < '0' || r > '9' })
+	if end >= 0 {
+		digits = digits[:end]
+	}
+	set, err := strconv.Atoi(digits)
+	if err != nil {
+		return 0, false
+	}
+	return set, true
+}
+
+// GetTFTItems returns all existing Teamfight Tactics items
+func (c *Client) GetTFTItems() ([]TFTItem, error) {
+	return c.GetTFTItemsCtx(context.Background())
+}
+
+// GetTFTItemsCtx is like GetTFTItems but uses the given context for all requests
+func (c *Client) GetTFTItemsCtx(ctx context.Context) ([]TFTItem, error) {
+	var items map[string]TFTItem
+	var res []TFTItem
+	err := c.getTFTData(ctx, "/tft-item.json", &items, &c.tftItemsMu, &c.tftItemsUpdated,
+		func() bool { return len(c.tftItems) > 0 },
+		func() {
+			c.tftItems = make([]TFTItem, 0, len(items))
+			for _, item := range items {
+				c.tftItems = append(c.tftItems, item)
+			}
+		},
+		func() { res = append([]TFTItem{}, c.tftItems...) })
+	return res, err
+}
+
+// GetTFTTraits returns all existing Teamfight Tactics traits
+func (c *Client) GetTFTTraits() ([]TFTTrait, error) {
+	return c.GetTFTTraitsCtx(context.Background())
+}
+
+// GetTFTTraitsCtx is like GetTFTTraits but uses the given context for all requests
+func (c *Client) GetTFTTraitsCtx(ctx context.Context) ([]TFTTrait, error) {
+	var traits map[string]TFTTrait
+	var res []TFTTrait
+	err := c.getTFTData(ctx, "/tft-trait.json", &traits, &c.tftTraitsMu, &c.tftTraitsUpdated,
+		func() bool { return len(c.tftTraits) > 0 },
+		func() {
+			c.tftTraits = make([]TFTTrait, 0, len(traits))
+			for _, trait := range traits {
+				c.tftTraits = append(c.tftTraits, trait)
+			}
+		},
+		func() { res = append([]TFTTrait{}, c.tftTraits...) })
+	return res, err
+}
+
+// GetTFTAugments returns all existing Teamfight Tactics augments
+func (c *Client) GetTFTAugments() ([]TFTAugment, error) {
+	return c.GetTFTAugmentsCtx(context.Background())
+}
+
+// GetTFTAugmentsCtx is like GetTFTAugments but uses the given context for all requests
+func (c *Client) GetTFTAugmentsCtx(ctx context.Context) ([]TFTAugment, error) {
+	var augments map[string]TFTAugment
+	var res []TFTAugment
+	err := c.getTFTData(ctx, "/tft-augments.json", &augments, &c.tftAugmentsMu, &c.tftAugmentsUpdated,
+		func() bool { return len(c.tftAugments) > 0 },
+		func() {
+			c.tftAugments = make([]TFTAugment, 0, len(augments))
+			for _, augment := range augments {
+				c.tftAugments = append(c.tftAugments, augment)
+			}
+		},
+		func() { res = append([]TFTAugment{}, c.tftAugments...) })
+	return res, err
+}
+
+// GetTFTQueues returns all existing Teamfight Tactics queues
+func (c *Client) GetTFTQueues() ([]TFTQueue, error) {
+	return c.GetTFTQueuesCtx(context.Background())
+}
+
+// GetTFTQueuesCtx is like GetTFTQueues but uses the given context for all requests
+func (c *Client) GetTFTQueuesCtx(ctx context.Context) ([]TFTQueue, error) {
+	var queues map[string]TFTQueue
+	var res []TFTQueue
+	err := c.getTFTData(ctx, "/tft-queues.json", &queues, &c.tftQueuesMu, &c.tftQueuesUpdated,
+		func() bool { return len(c.tftQueues) > 0 },
+		func() {
+			c.tftQueues = make([]TFTQueue, 0, len(queues))
+			for _, queue := range queues {
+				c.tftQueues = append(c.tftQueues, queue)
+			}
+		},
+		func() { res = append([]TFTQueue{}, c.tftQueues...) })
+	return res, err
+}
+
+// GetTFTRegalia returns the ranked emblems of all tiers of the ranked Teamfight Tactics queues
+func (c *Client) GetTFTRegalia() ([]TFTRegalia, error) {
+	return c.GetTFTRegaliaCtx(context.Background())
+}
+
+// GetTFTRegaliaCtx is like GetTFTRegalia but uses the given context for all requests
+func (c *Client) GetTFTRegaliaCtx(ctx context.Context) ([]TFTRegalia, error) {
+	// the regalia are grouped by queue and tier
+	var regaliaByQueue map[string]map[string]TFTRegalia
+	var res []TFTRegalia
+	err := c.getTFTData(ctx, "/tft-regalia.json", &regaliaByQueue, &c.tftRegaliaMu, &c.tftRegaliaUpdated,
+		func() bool { return len(c.tftRegalia) > 0 },
+		func() {
+			c.tftRegalia = []TFTRegalia{}
+			for queue, tiers := range regaliaByQueue {
+				for tier, regalia := range tiers {
+					regalia.Queue, regalia.Tier = queue, tier
+					c.tftRegalia = append(c.tftRegalia, regalia)
+				}
+			}
+		},
+		func() { res = append([]TFTRegalia{}, c.tftRegalia...) })
+	return res, err
+}
+
+// GetTFTArenas returns all existing Teamfight Tactics arena skins
+func (c *Client) GetTFTArenas() ([]TFTArena, error) {
+	return c.GetTFTArenasCtx(context.Background())
+}
+
+// GetTFTArenasCtx is like GetTFTArenas but uses the given context for all requests
+func (c *Client) GetTFTArenasCtx(ctx context.Context) ([]TFTArena, error) {
+	var arenas map[string]TFTArena
+	var res []TFTArena
+	err := c.getTFTData(ctx, "/tft-arena.json", &arenas, &c.tftArenasMu, &c.tftArenasUpdated,
+		func() bool { return len(c.tftArenas) > 0 },
+		func() {
+			c.tftArenas = make([]TFTArena, 0, len(arenas))
+			for _, arena := range arenas {
+				c.tftArenas = append(c.tftArenas, arena)
+			}
+		},
+		func() { res = append([]TFTArena{}, c.tftArenas...) })
+	return res, err
+}
+
+// getTFTData fills the TFT cache guarded by mu if it is empty or has expired by decoding the given endpoint into
+// target and calling store afterwards. read copies the cache and is called with the lock held.
+func (c *Client) getTFTData(ctx context.Context, endpoint string, target interface{}, mu *sync.RWMutex,
+	updated *time.Time, cached func() bool, store func(), read func()) error {
+	c.refreshVersionIfExpired(ctx)
+	return c.getCached(mu, updated, cached, func() error {
+		if err := c.getInto(ctx, endpoint, target); err != nil {
+			return err
+		}
+		store()
+		return nil
+	}, read)
+}
+
+// ClearTFTChampionCache resets the cache of TFT champions
+func (c *Client) ClearTFTChampionCache() {
+	c.tftChampionsMu.Lock()
+	c.tftChampions = []TFTChampion{}
+	c.tftChampionsMu.Unlock()
+}
+
+// ClearTFTItemCache resets the cache of TFT items
+func (c *Client) ClearTFTItemCache() {
+	c.tftItemsMu.Lock()
+	c.tftItems = []TFTItem{}
+	c.tftItemsMu.Unlock()
+}
+
+// ClearTFTTraitCache resets the cache of TFT traits
+func (c *Client) ClearTFTTraitCache() {
+	c.tftTraitsMu.Lock()
+	c.tftTraits = []TFTTrait{}
+	c.tftTraitsMu.Unlock()
+}
+
+// ClearTFTAugmentCache resets the cache of TFT augments
+func (c *Client) ClearTFTAugmentCache() {
+	c.tftAugmentsMu.Lock()
+	c.tftAugments = []TFTAugment{}
+	c.tftAugmentsMu.Unlock()
+}
+
+// ClearTFTQueueCache resets the cache of TFT queues
+func (c *Client) ClearTFTQueueCache() {
+	c.tftQueuesMu.Lock()
+	c.tftQueues = []TFTQueue{}
+	c.tftQueuesMu.Unlock()
+}
+
+// ClearTFTRegaliaCache resets the cache of TFT regalia
+func (c *Client) ClearTFTRegaliaCache() {
+	c.tftRegaliaMu.Lock()
+	c.tftRegalia = []TFTRegalia{}
+	c.tftRegaliaMu.Unlock()
+}
+
+// ClearTFTArenaCache resets the cache of TFT arena skins
+func (c *Client) ClearTFTArenaCache() {
+	c.tftArenasMu.Lock()
+	c.tftArenas = []TFTArena{}
+	c.tftArenasMu.Unlock()
+}
@@ -0,0 +1,342 @@
+package datadragon
+
+import (
+	"net/http"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+func TestClient_GetTFTChampions(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		doer    internal.Doer
+		want    []TFTChampion
+		wantErr error
+	}{
+		{
+			name: "get response",
+			doer: dataDragonResponseDoer(map[string]TFTChampion{
+				"TFT9_Ahri": {ID: "TFT9_Ahri", Name: "Ahri", Tier: 4},
+			}),
+			want: []TFTChampion{{ID: "TFT9_Ahri", Name: "Ahri", Tier: 4}},
+		},
+		{
+			name:    "known error",
+			doer:    mock.NewStatusMockDoer(http.StatusForbidden),
+			wantErr: api.ErrForbidden,
+		},
+		{
+			name: "unknown error",
+			doer: mock.NewStatusMockDoer(999),
+			wantErr: api.Error{
+				Message:    "unknown error reason",
+				StatusCode: 999,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(tt.doer, api.RegionEuropeWest, log.StandardLogger())
+			got, err := c.GetTFTChampions()
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.want, got)
+				got, err := c.GetTFTChampions()
+				assert.Nil(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestClient_GetTFTItems(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		doer    internal.Doer
+		want    []TFTItem
+		wantErr error
+	}{
+		{
+			name: "get response",
+			doer: dataDragonResponseDoer(map[string]TFTItem{
+				"TFT_Item_BFSword": {ID: "TFT_Item_BFSword", Name: "B.F. Sword"},
+			}),
+			want: []TFTItem{{ID: "TFT_Item_BFSword", Name: "B.F. Sword"}},
+		},
+		{
+			name:    "known error",
+			doer:    mock.NewStatusMockDoer(http.StatusForbidden),
+			wantErr: api.ErrForbidden,
+		},
+		{
+			name: "unknown error",
+			doer: mock.NewStatusMockDoer(999),
+			wantErr: api.Error{
+				Message:    "unknown error reason",
+				StatusCode: 999,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(tt.doer, api.RegionEuropeWest, log.StandardLogger())
+			got, err := c.GetTFTItems()
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.want, got)
+				got, err := c.GetTFTItems()
+				assert.Nil(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestClient_GetTFTTraits(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		doer    internal.Doer
+		want    []TFTTrait
+		wantErr error
+	}{
+		{
+			name: "get response",
+			doer: dataDragonResponseDoer(map[string]TFTTrait{
+				"Set9_Bastion": {
+					ID:   "Set9_Bastion",
+					Name: "Bastion",
+					Sets: []TFTTraitSet{{Style: "bronze", Min: 2, Max: 3}},
+				},
+			}),
+			want: []TFTTrait{
+				{ID: "Set9_Bastion", Name: "Bastion", Sets: []TFTTraitSet{{Style: "bronze", Min: 2, Max: 3}}},
+			},
+		},
+		{
+			name:    "known error",
+			doer:    mock.NewStatusMockDoer(http.StatusForbidden),
+			wantErr: api.ErrForbidden,
+		},
+		{
+			name: "unknown error",
+			doer: mock.NewStatusMockDoer(999),
+			wantErr: api.Error{
+				Message:    "unknown error reason",
+				StatusCode: 999,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(tt.doer, api.RegionEuropeWest, log.StandardLogger())
+			got, err := c.GetTFTTraits()
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.want, got)
+				got, err := c.GetTFTTraits()
+				assert.Nil(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestClient_GetTFTAugments(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		doer    internal.Doer
+		want    []TFTAugment
+		wantErr error
+	}{
+		{
+			name: "get response",
+			doer: dataDragonResponseDoer(map[string]TFTAugment{
+				"TFT9_Augment_Ascension": {ID: "TFT9_Augment_Ascension", Name: "Ascension", Tier: 3},
+			}),
+			want: []TFTAugment{{ID: "TFT9_Augment_Ascension", Name: "Ascension", Tier: 3}},
+		},
+		{
+			name:    "known error",
+			doer:    mock.NewStatusMockDoer(http.StatusForbidden),
+			wantErr: api.ErrForbidden,
+		},
+		{
+			name: "unknown error",
+			doer: mock.NewStatusMockDoer(999),
+			wantErr: api.Error{
+				Message:    "unknown error reason",
+				StatusCode: 999,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(tt.doer, api.RegionEuropeWest, log.StandardLogger())
+			got, err := c.GetTFTAugments()
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.want, got)
+				got, err := c.GetTFTAugments()
+				assert.Nil(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestClient_GetTFTQueues(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		doer    internal.Doer
+		want    []TFTQueue
+		wantErr error
+	}{
+		{
+			name: "get response",
+			doer: dataDragonResponseDoer(map[string]TFTQueue{
+				"1100": {ID: "1100", Name: "Ranked", QueueType: "RANKED_TFT"},
+			}),
+			want: []TFTQueue{{ID: "1100", Name: "Ranked", QueueType: "RANKED_TFT"}},
+		},
+		{
+			name:    "known error",
+			doer:    mock.NewStatusMockDoer(http.StatusForbidden),
+			wantErr: api.ErrForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(tt.doer, api.RegionEuropeWest, log.StandardLogger())
+			got, err := c.GetTFTQueues()
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.want, got)
+				got, err := c.GetTFTQueues()
+				assert.Nil(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestClient_GetTFTRegalia(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		doer    internal.Doer
+		want    []TFTRegalia
+		wantErr error
+	}{
+		{
+			name: "get response",
+			doer: dataDragonResponseDoer(map[string]map[string]TFTRegalia{
+				"RANKED_TFT": {"Gold": {Image: ImageData{Full: "TFT_Regalia_Gold.png"}}},
+			}),
+			want: []TFTRegalia{{Queue: "RANKED_TFT", Tier: "Gold", Image: ImageData{Full: "TFT_Regalia_Gold.png"}}},
+		},
+		{
+			name:    "known error",
+			doer:    mock.NewStatusMockDoer(http.StatusForbidden),
+			wantErr: api.ErrForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(tt.doer, api.RegionEuropeWest, log.StandardLogger())
+			got, err := c.GetTFTRegalia()
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.want, got)
+				got, err := c.GetTFTRegalia()
+				assert.Nil(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestClient_GetTFTArenas(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		doer    internal.Doer
+		want    []TFTArena
+		wantErr error
+	}{
+		{
+			name: "get response",
+			doer: dataDragonResponseDoer(map[string]TFTArena{
+				"1": {ID: "1", Name: "Default Arena", Image: ImageData{Full: "Arena_Default.png"}},
+			}),
+			want: []TFTArena{{ID: "1", Name: "Default Arena", Image: ImageData{Full: "Arena_Default.png"}}},
+		},
+		{
+			name:    "known error",
+			doer:    mock.NewStatusMockDoer(http.StatusForbidden),
+			wantErr: api.ErrForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(tt.doer, api.RegionEuropeWest, log.StandardLogger())
+			got, err := c.GetTFTArenas()
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.want, got)
+				got, err := c.GetTFTArenas()
+				assert.Nil(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestClient_GetTFTChampionsForSet(t *testing.T) {
+	t.Parallel()
+	doer := dataDragonResponseDoer(map[string]TFTChampion{
+		"Maps/Shipping/Map22/Sets/TFTSet9/Shop/TFT9_Ahri":   {ID: "TFT9_Ahri", Name: "Ahri"},
+		"Maps/Shipping/Map22/Sets/TFTSet10/Shop/TFT10_Ahri": {ID: "TFT10_Ahri", Name: "Ahri"},
+		"TFTTutorial_Garen": {ID: "TFTTutorial_Garen", Name: "Garen"},
+	})
+	tests := []struct {
+		name string
+		set  int
+		want []TFTChampion
+	}{
+		{
+			name: "current set",
+			set:  10,
+			want: []TFTChampion{{ID: "TFT10_Ahri", Name: "Ahri"}},
+		},
+		{
+			name: "older set",
+			set:  9,
+			want: []TFTChampion{{ID: "TFT9_Ahri", Name: "Ahri"}},
+		},
+		{
+			name: "unknown set",
+			set:  1,
+			want: []TFTChampion{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(doer, api.RegionEuropeWest, log.StandardLogger())
+			got, err := c.GetTFTChampionsForSet(tt.set)
+			require.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -5,4 +5,5 @@ go 1.12
 require (
 	github.com/sirupsen/logrus v1.4.2
 	github.com/stretchr/testify v1.3.0
+	golang.org/x/sync v0.0.0-20190911185100-cd5d95a43a6e
 )
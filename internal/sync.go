@@ -6,6 +6,8 @@ import "sync"
 // the first function returned should be used to unlock the mutex
 // the second function returned will unlock the read lock and instead lock the mutex for writing
 // the unlock function will always call the correct unlock method
+// since other goroutines may acquire the lock in between, any condition checked under the read lock has to be checked
+// again after calling the second function
 func RWLockToggle(mu *sync.RWMutex) (func(), func()) {
 	sw := true
 	mu.RLock()
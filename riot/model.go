@@ -284,12 +284,12 @@ func (p *Participant) GetChampion(client *datadragon.Client) (datadragon.Champio
 
 // GetSpell1 returns the first summoner spell of this participant
 func (p *Participant) GetSpell1(client *datadragon.Client) (datadragon.SummonerSpell, error) {
-	return client.GetSummonerSpell(strconv.Itoa(p.Spell1ID))
+	return client.GetSummonerSpellByKey(strconv.Itoa(p.Spell1ID))
 }
 
 // GetSpell2 returns the second summoner spell of this participant
 func (p *Participant) GetSpell2(client *datadragon.Client) (datadragon.SummonerSpell, error) {
-	return client.GetSummonerSpell(strconv.Itoa(p.Spell2ID))
+	return client.GetSummonerSpellByKey(strconv.Itoa(p.Spell2ID))
 }
 
 // ParticipantStats contains stats of a participant in a game
@@ -650,12 +650,12 @@ func (p *CurrentGameParticipant) GetChampion(client *datadragon.Client) (datadra
 
 // GetSpell1 returns the first summoner spell of this participant
 func (p *CurrentGameParticipant) GetSpell1(client *datadragon.Client) (datadragon.SummonerSpell, error) {
-	return client.GetSummonerSpell(strconv.Itoa(p.Spell1ID))
+	return client.GetSummonerSpellByKey(strconv.Itoa(p.Spell1ID))
 }
 
 // GetSpell2 returns the second summoner spell of this participant
 func (p *CurrentGameParticipant) GetSpell2(client *datadragon.Client) (datadragon.SummonerSpell, error) {
-	return client.GetSummonerSpell(strconv.Itoa(p.Spell2ID))
+	return client.GetSummonerSpellByKey(strconv.Itoa(p.Spell2ID))
 }
 
 // GameCustomizationObject contains information specific to an ongoing game
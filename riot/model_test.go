@@ -59,15 +59,15 @@ func TestChampionInfo_GetChampionsForNewPlayers(t *testing.T) {
 		{
 			name: "valid",
 			doer: dataDragonResponseDoer(map[string]datadragon.ChampionData{
-				"champion1": {ID: "1", Name: "champion1"},
-				"champion2": {ID: "2", Name: "champion2"},
+				"champion1": {Name: "champion1", ID: "champion1", Key: "1"},
+				"champion2": {Name: "champion2", ID: "champion2", Key: "2"},
 			}),
 			model: ChampionInfo{
 				FreeChampionIDsForNewPlayers: []int{1, 2},
 			},
 			want: []datadragon.ChampionDataExtended{
-				{ChampionData: datadragon.ChampionData{Name: "champion1", ID: "1"}},
-				{ChampionData: datadragon.ChampionData{Name: "champion2", ID: "2"}},
+				{ChampionData: datadragon.ChampionData{Name: "champion1", ID: "champion1", Key: "1"}},
+				{ChampionData: datadragon.ChampionData{Name: "champion2", ID: "champion2", Key: "2"}},
 			},
 		},
 		{
@@ -104,15 +104,15 @@ func TestChampionInfo_GetChampions(t *testing.T) {
 		{
 			name: "valid",
 			doer: dataDragonResponseDoer(map[string]datadragon.ChampionData{
-				"champion1": {ID: "1", Name: "champion1"},
-				"champion2": {ID: "2", Name: "champion2"},
+				"champion1": {Name: "champion1", ID: "champion1", Key: "1"},
+				"champion2": {Name: "champion2", ID: "champion2", Key: "2"},
 			}),
 			model: ChampionInfo{
 				FreeChampionIDs: []int{1, 2},
 			},
 			want: []datadragon.ChampionDataExtended{
-				{ChampionData: datadragon.ChampionData{Name: "champion1", ID: "1"}},
-				{ChampionData: datadragon.ChampionData{Name: "champion2", ID: "2"}},
+				{ChampionData: datadragon.ChampionData{Name: "champion1", ID: "champion1", Key: "1"}},
+				{ChampionData: datadragon.ChampionData{Name: "champion2", ID: "champion2", Key: "2"}},
 			},
 		},
 		{
@@ -175,10 +175,12 @@ func TestChampionMastery_GetChampion(t *testing.T) {
 		{
 			name: "valid",
 			doer: dataDragonResponseDoer(map[string]datadragon.ChampionData{
-				"champion": {Name: "champion", ID: "1"},
+				"champion": {Name: "champion", ID: "champion", Key: "1"},
 			}),
 			model: ChampionMastery{ChampionID: 1},
-			want:  datadragon.ChampionDataExtended{ChampionData: datadragon.ChampionData{Name: "champion", ID: "1"}},
+			want: datadragon.ChampionDataExtended{
+				ChampionData: datadragon.ChampionData{Name: "champion", ID: "champion", Key: "1"},
+			},
 		},
 	}
 	for _, test := range tests {
@@ -413,10 +415,12 @@ func TestTeamBan_GetChampion(t *testing.T) {
 		{
 			name: "valid",
 			doer: dataDragonResponseDoer(map[string]datadragon.ChampionData{
-				"champion": {Name: "champion", ID: "1"},
+				"champion": {Name: "champion", ID: "champion", Key: "1"},
 			}),
 			model: TeamBan{ChampionID: 1},
-			want:  datadragon.ChampionDataExtended{ChampionData: datadragon.ChampionData{Name: "champion", ID: "1"}},
+			want: datadragon.ChampionDataExtended{
+				ChampionData: datadragon.ChampionData{Name: "champion", ID: "champion", Key: "1"},
+			},
 		},
 	}
 	for _, test := range tests {
@@ -441,10 +445,12 @@ func TestParticipant_GetChampion(t *testing.T) {
 		{
 			name: "valid",
 			doer: dataDragonResponseDoer(map[string]datadragon.ChampionData{
-				"champion": {Name: "champion", ID: "1"},
+				"champion": {Name: "champion", ID: "champion", Key: "1"},
 			}),
 			model: Participant{ChampionID: 1},
-			want:  datadragon.ChampionDataExtended{ChampionData: datadragon.ChampionData{Name: "champion", ID: "1"}},
+			want: datadragon.ChampionDataExtended{
+				ChampionData: datadragon.ChampionData{Name: "champion", ID: "champion", Key: "1"},
+			},
 		},
 	}
 	for _, test := range tests {
@@ -469,10 +475,10 @@ func TestParticipant_GetSpell1(t *testing.T) {
 		{
 			name: "valid",
 			doer: dataDragonResponseDoer(map[string]datadragon.SummonerSpell{
-				"champion": {ID: "1"},
+				"SummonerHeal": {ID: "SummonerHeal", Key: "7"},
 			}),
-			model: Participant{Spell1ID: 1},
-			want:  datadragon.SummonerSpell{ID: "1"},
+			model: Participant{Spell1ID: 7},
+			want:  datadragon.SummonerSpell{ID: "SummonerHeal", Key: "7"},
 		},
 	}
 	for _, test := range tests {
@@ -497,10 +503,10 @@ func TestParticipant_GetSpell2(t *testing.T) {
 		{
 			name: "valid",
 			doer: dataDragonResponseDoer(map[string]datadragon.SummonerSpell{
-				"champion": {ID: "2"},
+				"SummonerFlash": {ID: "SummonerFlash", Key: "4"},
 			}),
-			model: Participant{Spell2ID: 2},
-			want:  datadragon.SummonerSpell{ID: "2"},
+			model: Participant{Spell2ID: 4},
+			want:  datadragon.SummonerSpell{ID: "SummonerFlash", Key: "4"},
 		},
 	}
 	for _, test := range tests {
@@ -721,10 +727,12 @@ func TestBannedChampion_GetChampion(t *testing.T) {
 		{
 			name: "valid",
 			doer: dataDragonResponseDoer(map[string]datadragon.ChampionData{
-				"champion": {Name: "champion", ID: "1"},
+				"champion": {Name: "champion", ID: "champion", Key: "1"},
 			}),
 			model: BannedChampion{ChampionID: 1},
-			want:  datadragon.ChampionDataExtended{ChampionData: datadragon.ChampionData{Name: "champion", ID: "1"}},
+			want: datadragon.ChampionDataExtended{
+				ChampionData: datadragon.ChampionData{Name: "champion", ID: "champion", Key: "1"},
+			},
 		},
 	}
 	for _, test := range tests {
@@ -749,10 +757,12 @@ func TestCurrentGameParticipant_GetChampion(t *testing.T) {
 		{
 			name: "valid",
 			doer: dataDragonResponseDoer(map[string]datadragon.ChampionData{
-				"champion": {Name: "champion", ID: "1"},
+				"champion": {Name: "champion", ID: "champion", Key: "1"},
 			}),
 			model: CurrentGameParticipant{ChampionID: 1},
-			want:  datadragon.ChampionDataExtended{ChampionData: datadragon.ChampionData{Name: "champion", ID: "1"}},
+			want: datadragon.ChampionDataExtended{
+				ChampionData: datadragon.ChampionData{Name: "champion", ID: "champion", Key: "1"},
+			},
 		},
 	}
 	for _, test := range tests {
@@ -777,10 +787,10 @@ func TestCurrentGameParticipant_GetSpell1(t *testing.T) {
 		{
 			name: "valid",
 			doer: dataDragonResponseDoer(map[string]datadragon.SummonerSpell{
-				"champion": {ID: "1"},
+				"SummonerHeal": {ID: "SummonerHeal", Key: "7"},
 			}),
-			model: CurrentGameParticipant{Spell1ID: 1},
-			want:  datadragon.SummonerSpell{ID: "1"},
+			model: CurrentGameParticipant{Spell1ID: 7},
+			want:  datadragon.SummonerSpell{ID: "SummonerHeal", Key: "7"},
 		},
 	}
 	for _, test := range tests {
@@ -805,10 +815,10 @@ func TestCurrentGameParticipant_GetSpell2(t *testing.T) {
 		{
 			name: "valid",
 			doer: dataDragonResponseDoer(map[string]datadragon.SummonerSpell{
-				"champion": {ID: "2"},
+				"SummonerFlash": {ID: "SummonerFlash", Key: "4"},
 			}),
-			model: CurrentGameParticipant{Spell2ID: 2},
-			want:  datadragon.SummonerSpell{ID: "2"},
+			model: CurrentGameParticipant{Spell2ID: 4},
+			want:  datadragon.SummonerSpell{ID: "SummonerFlash", Key: "4"},
 		},
 	}
 	for _, test := range tests {
@@ -887,10 +897,12 @@ func TestMatchReference_GetChampion(t *testing.T) {
 		{
 			name: "valid",
 			doer: dataDragonResponseDoer(map[string]datadragon.ChampionData{
-				"champion": {Name: "champion", ID: "1"},
+				"champion": {Name: "champion", ID: "champion", Key: "1"},
 			}),
 			model: MatchReference{Champion: 1},
-			want:  datadragon.ChampionDataExtended{ChampionData: datadragon.ChampionData{Name: "champion", ID: "1"}},
+			want: datadragon.ChampionDataExtended{
+				ChampionData: datadragon.ChampionData{Name: "champion", ID: "champion", Key: "1"},
+			},
 		},
 	}
 	for _, test := range tests {